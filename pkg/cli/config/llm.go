@@ -16,6 +16,7 @@ type LLM struct {
 	provider         string
 	model            string
 	openaiAPIKey     string
+	openaiBaseURL    string
 	claudeAPIKey     string
 	geminiProjectID  string
 	geminiLocation   string
@@ -41,6 +42,12 @@ func (x *LLM) Flags() []cli.Flag {
 			Sources:     cli.EnvVars("SHEPHERD_LLM_OPENAI_API_KEY"),
 			Destination: &x.openaiAPIKey,
 		},
+		&cli.StringFlag{
+			Name:        "llm-openai-base-url",
+			Usage:       "Base URL of an OpenAI-compatible API (e.g. http://localhost:11434/v1 for Ollama); the API key becomes optional when set",
+			Sources:     cli.EnvVars("SHEPHERD_LLM_OPENAI_BASE_URL"),
+			Destination: &x.openaiBaseURL,
+		},
 		&cli.StringFlag{
 			Name:        "llm-claude-api-key",
 			Usage:       "Anthropic Claude API key (used when --llm-provider=claude with direct Anthropic access)",
@@ -74,13 +81,19 @@ func (x *LLM) NewClient(ctx context.Context) (gollem.LLMClient, error) {
 
 	switch x.provider {
 	case "openai":
-		if x.openaiAPIKey == "" {
-			return nil, goerr.New("--llm-openai-api-key is required when --llm-provider=openai")
+		// Self-hosted OpenAI-compatible servers (Ollama, vLLM) usually
+		// accept any key, so the key is only mandatory for the default
+		// api.openai.com endpoint.
+		if x.openaiAPIKey == "" && x.openaiBaseURL == "" {
+			return nil, goerr.New("--llm-openai-api-key is required when --llm-provider=openai unless --llm-openai-base-url is set")
 		}
 		var opts []openai.Option
 		if x.model != "" {
 			opts = append(opts, openai.WithModel(x.model))
 		}
+		if x.openaiBaseURL != "" {
+			opts = append(opts, openai.WithBaseURL(x.openaiBaseURL))
+		}
 		client, err := openai.New(ctx, x.openaiAPIKey, opts...)
 		if err != nil {
 			return nil, goerr.Wrap(err, "failed to create OpenAI client")
@@ -2,8 +2,12 @@ package config_test
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/m-mizutani/gollem"
 	"github.com/m-mizutani/gt"
 	"github.com/m-mizutani/shepherd/pkg/cli/config"
 	"github.com/urfave/cli/v3"
@@ -18,6 +22,7 @@ func clearLLMEnv(t *testing.T) {
 		"SHEPHERD_LLM_PROVIDER",
 		"SHEPHERD_LLM_MODEL",
 		"SHEPHERD_LLM_OPENAI_API_KEY",
+		"SHEPHERD_LLM_OPENAI_BASE_URL",
 		"SHEPHERD_LLM_CLAUDE_API_KEY",
 		"SHEPHERD_LLM_GEMINI_PROJECT_ID",
 		"SHEPHERD_LLM_GEMINI_LOCATION",
@@ -58,6 +63,38 @@ func TestLLM_OpenAIRequiresAPIKey(t *testing.T) {
 	gt.Error(t, err)
 }
 
+func TestLLM_OpenAIBaseURL(t *testing.T) {
+	var gotPath, gotModel string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		var body struct {
+			Model string `json:"model"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		gotModel = body.Model
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"c1","object":"chat.completion","model":"llama3.1","choices":[{"index":0,"message":{"role":"assistant","content":"pong"},"finish_reason":"stop"}]}`))
+	}))
+	defer srv.Close()
+
+	// No API key: a base URL alone is enough for a self-hosted endpoint.
+	llm, err := runWithLLMArgs(t, []string{
+		"--llm-provider", "openai",
+		"--llm-model", "llama3.1",
+		"--llm-openai-base-url", srv.URL + "/v1",
+	})
+	gt.NoError(t, err)
+	client, err := llm.NewClient(context.Background())
+	gt.NoError(t, err).Required()
+
+	session := gt.R1(client.NewSession(context.Background())).NoError(t)
+	resp := gt.R1(session.Generate(context.Background(), []gollem.Input{gollem.Text("ping")})).NoError(t)
+	gt.A(t, resp.Texts).Length(1)
+	gt.S(t, resp.Texts[0]).Equal("pong")
+	gt.S(t, gotPath).Equal("/v1/chat/completions")
+	gt.S(t, gotModel).Equal("llama3.1")
+}
+
 func TestLLM_ClaudeRequiresKeyOrGCP(t *testing.T) {
 	llm, err := runWithLLMArgs(t, []string{"--llm-provider", "claude"})
 	gt.NoError(t, err)
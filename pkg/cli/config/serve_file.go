@@ -0,0 +1,158 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/pelletier/go-toml/v2"
+	"github.com/urfave/cli/v3"
+)
+
+const serveFileFlagName = "serve-config"
+
+// ServeFile loads default values for serve flags from a TOML file. Keys
+// are flag names without the leading dashes, e.g.
+//
+//	addr = "0.0.0.0:8080"
+//	slack-bot-token = "xoxb-..."
+//	config = ["./workspaces"]
+//
+// A value from the file is only used when the flag was given neither on
+// the command line nor through its environment variable, so the
+// precedence is flag > env > file > built-in default.
+type ServeFile struct {
+	path string
+}
+
+func (x *ServeFile) Flags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:        serveFileFlagName,
+			Usage:       "TOML file with default values for serve flags (command-line flags and environment variables take precedence)",
+			Sources:     cli.EnvVars("SHEPHERD_SERVE_CONFIG"),
+			Destination: &x.path,
+		},
+	}
+}
+
+// Apply sets every flag of c that the file provides and that is not set
+// yet. It must run from the command's Before hook so required-flag checks
+// and flag Destinations see the file values. Unknown keys are rejected
+// before any flag is touched, so a typo never half-applies the file.
+func (x *ServeFile) Apply(c *cli.Command) error {
+	return x.apply(c, c.Flags)
+}
+
+// ApplyShared is Apply for a command such as validate that takes a subset
+// of the serve flags, so the same file can be checked before a rollout.
+// Keys must still name one of serveFlags; keys for flags c does not have
+// are ignored.
+func (x *ServeFile) ApplyShared(c *cli.Command, serveFlags []cli.Flag) error {
+	return x.apply(c, serveFlags)
+}
+
+func (x *ServeFile) apply(c *cli.Command, known []cli.Flag) error {
+	if x.path == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(x.path) // #nosec G304 -- path is supplied by the operator
+	if err != nil {
+		return goerr.Wrap(err, "failed to read serve config", goerr.V("path", x.path))
+	}
+
+	var values map[string]any
+	if err := toml.Unmarshal(data, &values); err != nil {
+		return goerr.Wrap(err, "failed to parse serve config", goerr.V("path", x.path))
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var unknown []string
+	for _, key := range keys {
+		if key == serveFileFlagName || !hasFlag(known, key) {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) > 0 {
+		return goerr.New("unknown keys in serve config, keys must be serve flag names",
+			goerr.V("path", x.path),
+			goerr.V("keys", unknown))
+	}
+
+	for _, key := range keys {
+		if !hasFlag(c.Flags, key) || c.IsSet(key) {
+			continue
+		}
+		args, err := serveFileValues(values[key])
+		if err != nil {
+			return goerr.Wrap(err, "invalid value in serve config",
+				goerr.V("path", x.path),
+				goerr.V("key", key))
+		}
+		for _, arg := range args {
+			if err := c.Set(key, arg); err != nil {
+				return goerr.Wrap(err, "invalid value in serve config",
+					goerr.V("path", x.path),
+					goerr.V("key", key))
+			}
+		}
+	}
+	return nil
+}
+
+func hasFlag(flags []cli.Flag, name string) bool {
+	for _, f := range flags {
+		if slices.Contains(f.Names(), name) {
+			return true
+		}
+	}
+	return false
+}
+
+// serveFileValues converts a decoded TOML value into the string arguments
+// a flag would receive on the command line. Arrays become one argument per
+// element, which is how repeatable flags such as --config are given.
+func serveFileValues(v any) ([]string, error) {
+	if items, ok := v.([]any); ok {
+		args := make([]string, 0, len(items))
+		for _, item := range items {
+			arg, err := serveFileScalar(item)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+		}
+		return args, nil
+	}
+
+	arg, err := serveFileScalar(v)
+	if err != nil {
+		return nil, err
+	}
+	return []string{arg}, nil
+}
+
+func serveFileScalar(v any) (string, error) {
+	switch val := v.(type) {
+	case string:
+		return val, nil
+	case bool:
+		return strconv.FormatBool(val), nil
+	case int64:
+		return strconv.FormatInt(val, 10), nil
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64), nil
+	default:
+		return "", goerr.New("value must be a string, number, boolean or an array of them",
+			goerr.V("type", fmt.Sprintf("%T", v)))
+	}
+}
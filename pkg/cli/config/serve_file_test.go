@@ -0,0 +1,165 @@
+package config_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+	"github.com/m-mizutani/shepherd/pkg/cli/config"
+	"github.com/urfave/cli/v3"
+)
+
+type serveFileTarget struct {
+	addr    string
+	token   string
+	workers int
+	warmup  bool
+	paths   []string
+}
+
+func writeServeFile(t *testing.T, body string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "serve.toml")
+	gt.NoError(t, os.WriteFile(path, []byte(body), 0o600)).Required()
+	return path
+}
+
+func runWithServeFile(t *testing.T, args []string) (*serveFileTarget, error) {
+	t.Helper()
+	// Unset rather than empty: an empty env value still counts as set.
+	t.Setenv("SHEPHERD_SERVE_CONFIG", "")
+	_ = os.Unsetenv("SHEPHERD_SERVE_CONFIG")
+
+	var serveFile config.ServeFile
+	got := &serveFileTarget{}
+	flags := []cli.Flag{
+		&cli.StringFlag{Name: "addr", Value: "localhost:8080", Sources: cli.EnvVars("SHEPHERD_TEST_ADDR"), Destination: &got.addr},
+		&cli.StringFlag{Name: "token", Required: true, Destination: &got.token},
+		&cli.IntFlag{Name: "workers", Value: 1, Destination: &got.workers},
+		&cli.BoolFlag{Name: "warmup", Destination: &got.warmup},
+		&cli.StringSliceFlag{Name: "config", Value: []string{"./config.toml"}, Destination: &got.paths},
+	}
+	flags = append(flags, serveFile.Flags()...)
+
+	cmd := &cli.Command{
+		Name:  "test",
+		Flags: flags,
+		Before: func(ctx context.Context, c *cli.Command) (context.Context, error) {
+			return ctx, serveFile.Apply(c)
+		},
+		Action: func(_ context.Context, _ *cli.Command) error {
+			return nil
+		},
+	}
+	err := cmd.Run(context.Background(), append([]string{"test"}, args...))
+	return got, err
+}
+
+func TestServeFile_ProvidesDefaults(t *testing.T) {
+	path := writeServeFile(t, `
+addr = "0.0.0.0:9090"
+token = "from-file"
+workers = 4
+warmup = true
+config = ["./a.toml", "./b"]
+`)
+	got, err := runWithServeFile(t, []string{"--serve-config", path})
+	gt.NoError(t, err).Required()
+	gt.S(t, got.addr).Equal("0.0.0.0:9090")
+	// A required flag is satisfied by the file.
+	gt.S(t, got.token).Equal("from-file")
+	gt.N(t, got.workers).Equal(4)
+	gt.True(t, got.warmup)
+	gt.A(t, got.paths).Equal([]string{"./a.toml", "./b"})
+}
+
+func TestServeFile_FlagAndEnvTakePrecedence(t *testing.T) {
+	path := writeServeFile(t, `
+addr = "0.0.0.0:9090"
+token = "from-file"
+workers = 4
+`)
+	t.Setenv("SHEPHERD_TEST_ADDR", "127.0.0.1:7070")
+
+	got, err := runWithServeFile(t, []string{"--serve-config", path, "--workers", "2"})
+	gt.NoError(t, err).Required()
+	gt.S(t, got.addr).Equal("127.0.0.1:7070")
+	gt.N(t, got.workers).Equal(2)
+	gt.S(t, got.token).Equal("from-file")
+}
+
+func TestServeFile_NotSetKeepsDefaults(t *testing.T) {
+	got, err := runWithServeFile(t, []string{"--token", "x"})
+	gt.NoError(t, err).Required()
+	gt.S(t, got.addr).Equal("localhost:8080")
+	gt.N(t, got.workers).Equal(1)
+}
+
+func TestServeFile_UnknownKeys(t *testing.T) {
+	path := writeServeFile(t, `
+token = "from-file"
+adr = "0.0.0.0:9090"
+serve-config = "other.toml"
+`)
+	_, err := runWithServeFile(t, []string{"--serve-config", path})
+	gt.Error(t, err).Contains("unknown keys in serve config")
+}
+
+func TestServeFile_InvalidValues(t *testing.T) {
+	cases := map[string]string{
+		"table":       "token = \"x\"\n[addr]\nhost = \"0.0.0.0\"\n",
+		"wrong type":  "token = \"x\"\nworkers = \"many\"\n",
+		"broken toml": "token = \n",
+	}
+	for name, body := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := runWithServeFile(t, []string{"--serve-config", writeServeFile(t, body)})
+			gt.Error(t, err)
+		})
+	}
+}
+
+func TestServeFile_MissingFile(t *testing.T) {
+	_, err := runWithServeFile(t, []string{"--serve-config", filepath.Join(t.TempDir(), "missing.toml")})
+	gt.Error(t, err).Contains("failed to read serve config")
+}
+
+func TestServeFile_ApplyShared(t *testing.T) {
+	t.Setenv("SHEPHERD_SERVE_CONFIG", "")
+	_ = os.Unsetenv("SHEPHERD_SERVE_CONFIG")
+
+	serveFlags := []cli.Flag{
+		&cli.StringFlag{Name: "addr"},
+		&cli.StringFlag{Name: "token"},
+	}
+	run := func(t *testing.T, body string) (string, error) {
+		t.Helper()
+		var serveFile config.ServeFile
+		var token string
+		flags := []cli.Flag{&cli.StringFlag{Name: "token", Destination: &token}}
+		flags = append(flags, serveFile.Flags()...)
+		cmd := &cli.Command{
+			Name:  "validate",
+			Flags: flags,
+			Before: func(ctx context.Context, c *cli.Command) (context.Context, error) {
+				return ctx, serveFile.ApplyShared(c, serveFlags)
+			},
+			Action: func(_ context.Context, _ *cli.Command) error {
+				return nil
+			},
+		}
+		err := cmd.Run(context.Background(), []string{"validate", "--serve-config", writeServeFile(t, body)})
+		return token, err
+	}
+
+	// addr is a serve flag the command lacks, so it is skipped.
+	token, err := run(t, "addr = \"0.0.0.0:9090\"\ntoken = \"from-file\"\n")
+	gt.NoError(t, err).Required()
+	gt.S(t, token).Equal("from-file")
+
+	// A key that is no serve flag is still rejected.
+	_, err = run(t, "token = \"x\"\nadr = \"0.0.0.0:9090\"\n")
+	gt.Error(t, err).Contains("unknown keys in serve config")
+}
@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"context"
+	"time"
+
+	"github.com/m-mizutani/shepherd/pkg/cli/config"
+	"github.com/m-mizutani/shepherd/pkg/domain/interfaces"
+)
+
+var (
+	SignSlackRequestForTest   = signSlackRequest
+	TestWebhookRequestForTest = testWebhookRequest
+	ValidateCommandForTest    = cmdValidate
+)
+
+type WarmupStepForTest struct {
+	Name string
+	Fn   func(ctx context.Context) error
+}
+
+func WarmupForTest(ctx context.Context, steps []WarmupStepForTest, timeout time.Duration) {
+	converted := make([]warmupStep, 0, len(steps))
+	for _, step := range steps {
+		converted = append(converted, warmupStep{name: step.Name, fn: step.Fn})
+	}
+	warmupDependencies(ctx, converted, timeout)
+}
+
+// WarmupStepNamesForTest returns the names of the steps serve would run.
+func WarmupStepNamesForTest(repo interfaces.Repository, slackPing func(ctx context.Context) error) []string {
+	var names []string
+	for _, step := range warmupSteps(repo, slackPing) {
+		names = append(names, step.name)
+	}
+	return names
+}
+
+// CheckSlackSigningSecretForTest exposes the outcome of the signing secret
+// check without reaching Slack.
+func CheckSlackSigningSecretForTest(cfg *config.Slack) (skipped bool, err error) {
+	r := checkSlackSigningSecret(cfg)
+	return r.skipped, r.err
+}
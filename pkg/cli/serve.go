@@ -51,8 +51,15 @@ func cmdServe() *cli.Command {
 		sentryCfg       config.Sentry
 		llmCfg          config.LLM
 		agentStorageCfg config.AgentStorage
+		serveFile       config.ServeFile
 
 		triageIterationCap int
+		eventConcurrency   int
+		eventQueueSize     int
+		triageConcurrency  int
+		triageQueueSize    int
+		warmup             bool
+		recordDir          string
 
 		// Tool factories own their own --flags via Flags() and are constructed
 		// up-front so the CLI flag list can be aggregated without pkg/cli
@@ -88,6 +95,46 @@ func cmdServe() *cli.Command {
 			Value:       10,
 			Destination: &triageIterationCap,
 		},
+		&cli.IntFlag{
+			Name:        "event-concurrency",
+			Usage:       "Maximum number of Slack events processed concurrently",
+			Sources:     cli.EnvVars("SHEPHERD_EVENT_CONCURRENCY"),
+			Value:       32,
+			Destination: &eventConcurrency,
+		},
+		&cli.IntFlag{
+			Name:        "event-queue-size",
+			Usage:       "Maximum number of Slack events waiting for a worker before new events are rejected with 503",
+			Sources:     cli.EnvVars("SHEPHERD_EVENT_QUEUE_SIZE"),
+			Value:       256,
+			Destination: &eventQueueSize,
+		},
+		&cli.IntFlag{
+			Name:        "triage-concurrency",
+			Usage:       "Maximum number of triage planner runs (LLM sessions) in flight at once",
+			Sources:     cli.EnvVars("SHEPHERD_TRIAGE_CONCURRENCY"),
+			Value:       8,
+			Destination: &triageConcurrency,
+		},
+		&cli.IntFlag{
+			Name:        "triage-queue-size",
+			Usage:       "Maximum number of triage runs waiting for a worker before new runs fail with a retry button",
+			Sources:     cli.EnvVars("SHEPHERD_TRIAGE_QUEUE_SIZE"),
+			Value:       64,
+			Destination: &triageQueueSize,
+		},
+		&cli.StringFlag{
+			Name:        "record-dir",
+			Usage:       "Directory to write every verified Slack webhook request to, for replay with test-webhook",
+			Sources:     cli.EnvVars("SHEPHERD_RECORD_DIR"),
+			Destination: &recordDir,
+		},
+		&cli.BoolFlag{
+			Name:        "warmup",
+			Usage:       "Open repository and Slack connections before accepting traffic",
+			Sources:     cli.EnvVars("SHEPHERD_WARMUP"),
+			Destination: &warmup,
+		},
 	}
 	flags = append(flags, workspaceCfg.Flags()...)
 	flags = append(flags, repoCfg.Flags()...)
@@ -96,11 +143,15 @@ func cmdServe() *cli.Command {
 	flags = append(flags, llmCfg.Flags()...)
 	flags = append(flags, agentStorageCfg.Flags()...)
 	flags = append(flags, notionFactory.Flags()...)
+	flags = append(flags, serveFile.Flags()...)
 
 	return &cli.Command{
 		Name:  "serve",
 		Usage: "Start HTTP server",
 		Flags: flags,
+		Before: func(ctx context.Context, c *cli.Command) (context.Context, error) {
+			return ctx, serveFile.Apply(c)
+		},
 		Action: func(ctx context.Context, c *cli.Command) error {
 			logger := logging.Default()
 
@@ -205,20 +256,42 @@ func cmdServe() *cli.Command {
 			promptUC := prompt.New(repo.Prompt())
 
 			var triageUC *triage.UseCase
+			var triagePool *async.Pool
 			if slackUC != nil {
+				triagePool = async.NewPool(triageConcurrency, triageQueueSize)
 				triageExec := triage.NewPlanExecutor(
 					repo, historyRepo, llmClient, slackClient, catalog, promptUC,
 					&triage.RegistryWorkspaceLookup{Registry: registry},
 					triage.Config{IterationCap: triageIterationCap, BaseURL: baseURL},
 				)
-				triageUC = triage.NewUseCase(triageExec, &triage.RegistryResolver{Registry: registry})
+				triageUC = triage.NewUseCase(triageExec, &triage.RegistryResolver{Registry: registry},
+					triage.WithPool(triagePool),
+				)
 				slackUC.SetTriageTrigger(triageUC)
 				logger.Info("Triage agent enabled",
 					"iteration_cap", triageIterationCap,
+					"concurrency", triageConcurrency,
+					"queue_size", triageQueueSize,
 				)
 			}
 
+			var eventPool *async.Pool
 			if slackUC != nil {
+				eventPool = async.NewPool(eventConcurrency, eventQueueSize)
+				logger.Info("Slack event pool configured",
+					"concurrency", eventConcurrency,
+					"queue_size", eventQueueSize,
+				)
+
+				if recordDir != "" {
+					if err := os.MkdirAll(recordDir, 0o700); err != nil {
+						return goerr.Wrap(err, "failed to create record directory", goerr.V("dir", recordDir))
+					}
+					logger.Warn("Recording Slack webhook payloads; files contain message content",
+						"dir", recordDir,
+					)
+				}
+
 				ticketUC := usecaseroot.NewTicketUseCase(repo, registry, slackClient, llmClient)
 				quickUC := usecaseroot.NewQuickActionsUseCase(repo, registry, ticketUC)
 				serverOpts = append(serverOpts, httpController.WithSlack(httpController.SlackConfig{
@@ -227,9 +300,19 @@ func cmdServe() *cli.Command {
 					Notifier:      slackClient,
 					TriageUC:      triageUC,
 					QuickUC:       quickUC,
+					EventPool:     eventPool,
+					RecordDir:     recordDir,
 				}))
 			}
 
+			// The Slack check only applies when the integration is enabled;
+			// a nil *Client must not reach the interface.
+			var readinessSlack usecaseroot.ReadinessSlackClient
+			if slackUC != nil {
+				readinessSlack = slackClient
+			}
+			serverOpts = append(serverOpts, httpController.WithReadiness(usecaseroot.NewReadinessUseCase(repo, readinessSlack)))
+
 			sourceUC := source.New(repo.Source(), notionFactory.Client(), time.Now)
 			serverOpts = append(serverOpts, httpController.WithSource(sourceUC, catalog))
 			serverOpts = append(serverOpts, httpController.WithPrompt(promptUC))
@@ -239,6 +322,14 @@ func cmdServe() *cli.Command {
 
 			httpServer := httpController.New(registry, repo, authUC, serverOpts...)
 
+			if warmup {
+				var slackPing func(ctx context.Context) error
+				if slackClient != nil {
+					slackPing = slackClient.AuthTest
+				}
+				warmupDependencies(ctx, warmupSteps(repo, slackPing), warmupTimeout)
+			}
+
 			server := &http.Server{
 				Addr:              addr,
 				Handler:           httpServer,
@@ -273,7 +364,15 @@ func cmdServe() *cli.Command {
 				return err
 			}
 
+			if eventPool != nil {
+				eventPool.Close()
+			}
 			async.Wait()
+			// Closed last: in-flight event and interaction handlers may still
+			// submit triage runs until async.Wait returns.
+			if triagePool != nil {
+				triagePool.Close()
+			}
 			logger.Info("Server stopped")
 			return nil
 		},
@@ -0,0 +1,95 @@
+package cli_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/m-mizutani/shepherd/pkg/cli"
+	httpController "github.com/m-mizutani/shepherd/pkg/controller/http"
+	slackgo "github.com/slack-go/slack"
+)
+
+const testSecret = "test-signing-secret"
+
+func verifySignature(t *testing.T, secret, signature, timestamp string, body []byte) error {
+	t.Helper()
+	header := http.Header{}
+	header.Set("X-Slack-Signature", signature)
+	header.Set("X-Slack-Request-Timestamp", timestamp)
+
+	sv := gt.R1(slackgo.NewSecretsVerifier(header, secret)).NoError(t)
+	_ = gt.R1(sv.Write(body)).NoError(t)
+	return sv.Ensure()
+}
+
+func TestSignSlackRequest_AcceptedBySlackVerifier(t *testing.T) {
+	body := []byte(`{"type":"event_callback","event":{"type":"app_mention","text":"<@UBOT> hi"}}`)
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+
+	sig := cli.SignSlackRequestForTest(testSecret, ts, body)
+	gt.NoError(t, verifySignature(t, testSecret, sig, ts, body))
+}
+
+func TestSignSlackRequest_RejectedWithWrongSecretOrBody(t *testing.T) {
+	body := []byte(`{"type":"url_verification","challenge":"abc123"}`)
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	sig := cli.SignSlackRequestForTest(testSecret, ts, body)
+
+	gt.Error(t, verifySignature(t, "other-secret", sig, ts, body))
+	gt.Error(t, verifySignature(t, testSecret, sig, ts, []byte(`{"type":"url_verification","challenge":"tampered"}`)))
+}
+
+func TestTestWebhookRequest_Recording(t *testing.T) {
+	rec := httpController.SlackRecording{
+		Path:   "/hooks/slack/interaction",
+		Header: http.Header{"Content-Type": {"application/x-www-form-urlencoded"}},
+		Body:   "payload=%7B%22type%22%3A%22block_actions%22%7D",
+	}
+	fixture := gt.R1(json.Marshal(rec)).NoError(t)
+
+	// --interaction is ignored: a recording always goes back to its own path.
+	for _, interaction := range []bool{false, true} {
+		endpoint, contentType, body := cli.TestWebhookRequestForTest("http://localhost:8080", fixture, interaction)
+		gt.S(t, endpoint).Equal("http://localhost:8080/hooks/slack/interaction")
+		gt.S(t, contentType).Equal("application/x-www-form-urlencoded")
+		gt.S(t, string(body)).Equal(rec.Body)
+	}
+}
+
+func TestTestWebhookRequest_RecordingWithoutContentType(t *testing.T) {
+	rec := httpController.SlackRecording{
+		Path: "/hooks/slack/event",
+		Body: `{"type":"url_verification","challenge":"abc123"}`,
+	}
+	fixture := gt.R1(json.Marshal(rec)).NoError(t)
+
+	endpoint, contentType, body := cli.TestWebhookRequestForTest("http://localhost:8080", fixture, false)
+	gt.S(t, endpoint).Equal("http://localhost:8080/hooks/slack/event")
+	gt.S(t, contentType).Equal("application/json")
+	gt.S(t, string(body)).Equal(rec.Body)
+}
+
+func TestTestWebhookRequest_EventFixture(t *testing.T) {
+	fixture := []byte(`{"type":"event_callback","event":{"type":"message","text":"help"}}`)
+
+	endpoint, contentType, body := cli.TestWebhookRequestForTest("http://localhost:8080", fixture, false)
+	gt.S(t, endpoint).Equal("http://localhost:8080/hooks/slack/event")
+	gt.S(t, contentType).Equal("application/json")
+	gt.S(t, string(body)).Equal(string(fixture))
+}
+
+func TestTestWebhookRequest_InteractionFixture(t *testing.T) {
+	fixture := []byte(`{"type":"block_actions","actions":[{"action_id":"triage_submit_answers"}]}`)
+
+	endpoint, contentType, body := cli.TestWebhookRequestForTest("http://localhost:8080", fixture, true)
+	gt.S(t, endpoint).Equal("http://localhost:8080/hooks/slack/interaction")
+	gt.S(t, contentType).Equal("application/x-www-form-urlencoded")
+
+	form := gt.R1(url.ParseQuery(string(body))).NoError(t)
+	gt.S(t, form.Get("payload")).Equal(string(fixture))
+}
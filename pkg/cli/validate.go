@@ -3,20 +3,63 @@ package cli
 import (
 	"context"
 	"fmt"
+	"io"
 	"log/slog"
+	"time"
 
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/m-mizutani/gollem"
 	"github.com/m-mizutani/shepherd/pkg/cli/config"
 	"github.com/m-mizutani/shepherd/pkg/utils/logging"
 	"github.com/urfave/cli/v3"
 )
 
+// validateCheckTimeout bounds each network call made by validate so an
+// unreachable dependency is reported instead of hanging the command.
+const validateCheckTimeout = 10 * time.Second
+
+// readinessResult is one line of the report printed by validate. A nil err
+// with skipped set means the dependency is not configured, which is not a
+// failure on its own.
+type readinessResult struct {
+	name    string
+	detail  string
+	skipped bool
+	err     error
+}
+
 func cmdValidate() *cli.Command {
-	var workspaceCfg config.WorkspaceFiles
+	var (
+		workspaceCfg config.WorkspaceFiles
+		repoCfg      config.Repository
+		slackCfg     config.Slack
+		llmCfg       config.LLM
+		serveFile    config.ServeFile
+		checkLLMConn bool
+	)
+
+	flags := []cli.Flag{
+		&cli.BoolFlag{
+			Name:        "check-llm",
+			Usage:       "Send a minimal request to the LLM provider to verify connectivity (billed as one short completion)",
+			Sources:     cli.EnvVars("SHEPHERD_CHECK_LLM"),
+			Destination: &checkLLMConn,
+		},
+	}
+	flags = append(flags, workspaceCfg.Flags()...)
+	flags = append(flags, repoCfg.Flags()...)
+	flags = append(flags, slackCfg.Flags()...)
+	flags = append(flags, llmCfg.Flags()...)
+	flags = append(flags, serveFile.Flags()...)
 
 	return &cli.Command{
 		Name:  "validate",
-		Usage: "Validate configuration files",
-		Flags: workspaceCfg.Flags(),
+		Usage: "Validate configuration files and check configured dependencies",
+		Flags: flags,
+		Before: func(ctx context.Context, c *cli.Command) (context.Context, error) {
+			// Keys for serve-only flags such as addr are accepted and ignored.
+			return ctx, serveFile.ApplyShared(c, cmdServe().Flags)
+		},
 		Action: func(ctx context.Context, c *cli.Command) error {
 			logger := logging.Default()
 
@@ -34,8 +77,120 @@ func cmdValidate() *cli.Command {
 				)
 			}
 
-			fmt.Printf("All %d workspace(s) validated successfully.\n", len(workspaceConfigs))
-			return nil
+			w := c.Root().Writer
+			_, _ = fmt.Fprintf(w, "All %d workspace(s) validated successfully.\n", len(workspaceConfigs))
+
+			results := []readinessResult{
+				checkRepository(ctx, &repoCfg),
+				checkSlackBotToken(ctx, &slackCfg),
+				checkSlackSigningSecret(&slackCfg),
+				checkLLM(ctx, &llmCfg, checkLLMConn),
+			}
+			return printReadinessReport(w, results)
 		},
 	}
 }
+
+// printReadinessReport writes one line per result to w and returns an
+// error when any check failed, so the command exits non-zero.
+func printReadinessReport(w io.Writer, results []readinessResult) error {
+	failed := 0
+	_, _ = fmt.Fprintln(w, "Readiness report:")
+	for _, r := range results {
+		switch {
+		case r.err != nil:
+			failed++
+			_, _ = fmt.Fprintf(w, "  [FAIL] %s: %v\n", r.name, r.err)
+		case r.skipped:
+			_, _ = fmt.Fprintf(w, "  [SKIP] %s: %s\n", r.name, r.detail)
+		default:
+			_, _ = fmt.Fprintf(w, "  [ OK ] %s: %s\n", r.name, r.detail)
+		}
+	}
+
+	if failed > 0 {
+		return goerr.New("readiness check failed", goerr.V("failed", failed))
+	}
+	return nil
+}
+
+func checkRepository(ctx context.Context, cfg *config.Repository) readinessResult {
+	name := "repository (" + cfg.Backend() + ")"
+
+	repo, err := cfg.Configure(ctx)
+	if err != nil {
+		return readinessResult{name: name, err: err}
+	}
+	defer func() {
+		if err := repo.Close(); err != nil {
+			logging.Default().Warn("failed to close repository", slog.Any("error", err))
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, validateCheckTimeout)
+	defer cancel()
+	if err := repo.Ping(ctx); err != nil {
+		return readinessResult{name: name, err: err}
+	}
+	return readinessResult{name: name, detail: "reachable"}
+}
+
+func checkSlackBotToken(ctx context.Context, cfg *config.Slack) readinessResult {
+	const name = "slack bot token"
+	if cfg.BotToken() == "" {
+		return readinessResult{name: name, skipped: true, detail: "--slack-bot-token not set"}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, validateCheckTimeout)
+	defer cancel()
+	if err := cfg.NewSlackClient().AuthTest(ctx); err != nil {
+		return readinessResult{name: name, err: err}
+	}
+	return readinessResult{name: name, detail: "accepted by auth.test"}
+}
+
+// checkSlackSigningSecret only reports presence: the secret can't be
+// verified without a request signed by Slack. Serve only enables the Slack
+// integration when both are set, so a token with no secret is a failure.
+func checkSlackSigningSecret(cfg *config.Slack) readinessResult {
+	const name = "slack signing secret"
+	switch {
+	case cfg.SignSecret() != "":
+		return readinessResult{name: name, detail: "set"}
+	case cfg.BotToken() != "":
+		return readinessResult{name: name, err: goerr.New("--slack-signing-secret is required when --slack-bot-token is set")}
+	default:
+		return readinessResult{name: name, skipped: true, detail: "--slack-signing-secret not set"}
+	}
+}
+
+// checkLLM builds the client to validate provider credentials and options.
+// Building a client sends nothing, so for most providers it only proves the
+// flag combination is valid; that outcome is reported as skipped rather than
+// ok. Connectivity is only checked when sendRequest is set, because every
+// request is billed.
+func checkLLM(ctx context.Context, cfg *config.LLM, sendRequest bool) readinessResult {
+	const name = "llm"
+	if !cfg.IsEnabled() {
+		return readinessResult{name: name, skipped: true, detail: "--llm-provider not set (required by serve)"}
+	}
+
+	client, err := cfg.NewClient(ctx)
+	if err != nil {
+		return readinessResult{name: name, err: err}
+	}
+	if !sendRequest {
+		return readinessResult{name: name, skipped: true, detail: "configuration is valid; connectivity not checked (pass --check-llm)"}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, validateCheckTimeout)
+	defer cancel()
+	session, err := client.NewSession(ctx)
+	if err != nil {
+		return readinessResult{name: name, err: goerr.Wrap(err, "failed to start LLM session")}
+	}
+	if _, err := session.Generate(ctx, []gollem.Input{gollem.Text("Reply with OK.")}); err != nil {
+		return readinessResult{name: name, err: goerr.Wrap(err, "LLM test request failed")}
+	}
+	return readinessResult{name: name, detail: "provider answered a test request"}
+}
@@ -0,0 +1,210 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+	"github.com/m-mizutani/shepherd/pkg/cli"
+	"github.com/m-mizutani/shepherd/pkg/cli/config"
+	urfave "github.com/urfave/cli/v3"
+)
+
+const validateTOML = `
+[workspace]
+id = "test-ws"
+name = "Test Workspace"
+
+[ticket]
+default_status = "open"
+closed_statuses = ["closed"]
+
+[slack]
+channel = "C0123456789"
+
+[[statuses]]
+id = "open"
+name = "Open"
+
+[[statuses]]
+id = "closed"
+name = "Closed"
+`
+
+// clearValidateEnv unsets every environment variable that feeds a validate
+// flag, so values injected by the test runner don't enable extra checks.
+func clearValidateEnv(t *testing.T) {
+	t.Helper()
+	for _, k := range []string{
+		"SHEPHERD_CONFIG",
+		"SHEPHERD_CHECK_LLM",
+		"SHEPHERD_SERVE_CONFIG",
+		"SHEPHERD_REPOSITORY_BACKEND",
+		"SHEPHERD_SLACK_BOT_TOKEN",
+		"SHEPHERD_SLACK_SIGNING_SECRET",
+		"SHEPHERD_LLM_PROVIDER",
+		"SHEPHERD_LLM_MODEL",
+		"SHEPHERD_LLM_OPENAI_API_KEY",
+		"SHEPHERD_LLM_OPENAI_BASE_URL",
+		"SHEPHERD_LLM_CLAUDE_API_KEY",
+		"SHEPHERD_LLM_GEMINI_PROJECT_ID",
+		"SHEPHERD_LLM_GEMINI_LOCATION",
+	} {
+		t.Setenv(k, "")
+		_ = os.Unsetenv(k)
+	}
+}
+
+func runValidate(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	clearValidateEnv(t)
+
+	path := filepath.Join(t.TempDir(), "config.toml")
+	gt.NoError(t, os.WriteFile(path, []byte(validateTOML), 0o600)).Required()
+
+	var out bytes.Buffer
+	cmd := cli.ValidateCommandForTest()
+	cmd.Writer = &out
+	err := cmd.Run(context.Background(), append([]string{"validate", "--config", path}, args...))
+	return out.String(), err
+}
+
+// newOpenAIServer answers chat completions with status and counts requests.
+func newOpenAIServer(t *testing.T, status int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if status != http.StatusOK {
+			_, _ = w.Write([]byte(`{"error":{"message":"unavailable","type":"server_error"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"c1","object":"chat.completion","model":"llama3.1","choices":[{"index":0,"message":{"role":"assistant","content":"OK"},"finish_reason":"stop"}]}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func TestValidate_DefaultsSkipOptionalChecks(t *testing.T) {
+	out, err := runValidate(t)
+	gt.NoError(t, err).Required()
+	gt.S(t, out).Contains("All 1 workspace(s) validated successfully.")
+	gt.S(t, out).Contains("[ OK ] repository (memory): reachable")
+	gt.S(t, out).Contains("[SKIP] slack bot token")
+	gt.S(t, out).Contains("[SKIP] slack signing secret")
+	gt.S(t, out).Contains("[SKIP] llm")
+	gt.S(t, out).NotContains("[FAIL]")
+}
+
+func TestValidate_LLMFailureExitsNonZero(t *testing.T) {
+	// openai without an API key or base URL cannot build a client.
+	out, err := runValidate(t, "--llm-provider", "openai")
+	gt.Error(t, err).Contains("readiness check failed")
+	gt.S(t, out).Contains("[FAIL] llm")
+	gt.S(t, out).Contains("[ OK ] repository (memory)")
+}
+
+func TestValidate_LLMNotContactedWithoutFlag(t *testing.T) {
+	srv, calls := newOpenAIServer(t, http.StatusOK)
+
+	out, err := runValidate(t, "--llm-provider", "openai", "--llm-openai-base-url", srv.URL+"/v1")
+	gt.NoError(t, err).Required()
+	gt.S(t, out).Contains("[SKIP] llm: configuration is valid; connectivity not checked")
+	gt.N(t, calls.Load()).Equal(0)
+}
+
+func TestValidate_CheckLLM(t *testing.T) {
+	t.Run("provider answers", func(t *testing.T) {
+		srv, calls := newOpenAIServer(t, http.StatusOK)
+
+		out, err := runValidate(t, "--check-llm", "--llm-provider", "openai", "--llm-openai-base-url", srv.URL+"/v1")
+		gt.NoError(t, err).Required()
+		gt.S(t, out).Contains("[ OK ] llm: provider answered a test request")
+		gt.N(t, calls.Load()).Equal(1)
+	})
+
+	t.Run("provider fails", func(t *testing.T) {
+		srv, _ := newOpenAIServer(t, http.StatusBadRequest)
+
+		out, err := runValidate(t, "--check-llm", "--llm-provider", "openai", "--llm-openai-base-url", srv.URL+"/v1")
+		gt.Error(t, err).Contains("readiness check failed")
+		gt.S(t, out).Contains("[FAIL] llm")
+	})
+}
+
+func TestValidate_ServeConfig(t *testing.T) {
+	writeServeConfig := func(t *testing.T, body string) string {
+		t.Helper()
+		path := filepath.Join(t.TempDir(), "serve.toml")
+		gt.NoError(t, os.WriteFile(path, []byte(body), 0o600)).Required()
+		return path
+	}
+
+	t.Run("shared keys apply and serve-only keys are ignored", func(t *testing.T) {
+		path := writeServeConfig(t, `
+addr = "0.0.0.0:8080"
+event-queue-size = 512
+llm-provider = "openai"
+`)
+		out, err := runValidate(t, "--serve-config", path)
+		gt.Error(t, err).Contains("readiness check failed")
+		gt.S(t, out).Contains("[FAIL] llm")
+	})
+
+	t.Run("flag takes precedence over the file", func(t *testing.T) {
+		path := writeServeConfig(t, `llm-provider = "openai"`)
+		srv, _ := newOpenAIServer(t, http.StatusOK)
+
+		out, err := runValidate(t, "--serve-config", path, "--llm-openai-base-url", srv.URL+"/v1")
+		gt.NoError(t, err).Required()
+		gt.S(t, out).Contains("[SKIP] llm: configuration is valid")
+	})
+
+	t.Run("unknown key fails", func(t *testing.T) {
+		path := writeServeConfig(t, `adr = "0.0.0.0:8080"`)
+		_, err := runValidate(t, "--serve-config", path)
+		gt.Error(t, err).Contains("unknown keys in serve config")
+	})
+}
+
+func parseSlackFlags(t *testing.T, args ...string) *config.Slack {
+	t.Helper()
+	clearValidateEnv(t)
+
+	slack := &config.Slack{}
+	cmd := &urfave.Command{
+		Name:   "test",
+		Flags:  slack.Flags(),
+		Action: func(_ context.Context, _ *urfave.Command) error { return nil },
+	}
+	gt.NoError(t, cmd.Run(context.Background(), append([]string{"test"}, args...))).Required()
+	return slack
+}
+
+func TestCheckSlackSigningSecret(t *testing.T) {
+	t.Run("token without secret fails", func(t *testing.T) {
+		skipped, err := cli.CheckSlackSigningSecretForTest(parseSlackFlags(t, "--slack-bot-token", "xoxb-test"))
+		gt.False(t, skipped)
+		gt.Error(t, err).Contains("--slack-signing-secret is required")
+	})
+
+	t.Run("secret set", func(t *testing.T) {
+		skipped, err := cli.CheckSlackSigningSecretForTest(parseSlackFlags(t, "--slack-signing-secret", "s"))
+		gt.False(t, skipped)
+		gt.NoError(t, err)
+	})
+
+	t.Run("neither set is skipped", func(t *testing.T) {
+		skipped, err := cli.CheckSlackSigningSecretForTest(parseSlackFlags(t))
+		gt.True(t, skipped)
+		gt.NoError(t, err)
+	})
+}
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/m-mizutani/shepherd/pkg/domain/interfaces"
+	"github.com/m-mizutani/shepherd/pkg/utils/logging"
+)
+
+// warmupTimeout bounds each warmup call so a slow dependency delays startup
+// by at most this much.
+const warmupTimeout = 10 * time.Second
+
+type warmupStep struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+// warmupSteps lists the dependencies to warm up. slackPing is nil when
+// the Slack integration is disabled, and the Slack step is left out.
+//
+// The LLM provider is intentionally not pinged because every call is
+// billed.
+func warmupSteps(repo interfaces.Repository, slackPing func(ctx context.Context) error) []warmupStep {
+	steps := []warmupStep{
+		{name: "repository", fn: repo.Ping},
+	}
+	if slackPing != nil {
+		steps = append(steps, warmupStep{name: "slack", fn: slackPing})
+	}
+	return steps
+}
+
+// warmupDependencies runs each step to open the connections the first
+// Slack event would otherwise have to establish (Firestore gRPC channel,
+// TLS session to the Slack API) before the server starts accepting
+// traffic. Each step gets its own timeout. Failures are logged and the
+// remaining steps still run: warmup is an optimisation, and persistent
+// problems surface through /readyz and request handling.
+func warmupDependencies(ctx context.Context, steps []warmupStep, timeout time.Duration) {
+	logger := logging.From(ctx)
+
+	for _, step := range steps {
+		start := time.Now()
+		stepCtx, cancel := context.WithTimeout(ctx, timeout)
+		err := step.fn(stepCtx)
+		cancel()
+
+		if err != nil {
+			logger.Warn("Warmup failed",
+				slog.String("target", step.name),
+				slog.Any("error", err),
+			)
+			continue
+		}
+		logger.Info("Warmup completed",
+			slog.String("target", step.name),
+			slog.Duration("elapsed", time.Since(start)),
+		)
+	}
+}
@@ -0,0 +1,53 @@
+package cli_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/m-mizutani/shepherd/pkg/cli"
+	"github.com/m-mizutani/shepherd/pkg/repository/memory"
+)
+
+func TestWarmup_FailuresDoNotStopLaterSteps(t *testing.T) {
+	var ran []string
+	record := func(name string, err error) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			ran = append(ran, name)
+			return err
+		}
+	}
+	var hungErr error
+	hang := func(ctx context.Context) error {
+		ran = append(ran, "hung")
+		<-ctx.Done()
+		hungErr = ctx.Err()
+		return hungErr
+	}
+
+	cli.WarmupForTest(context.Background(), []cli.WarmupStepForTest{
+		{Name: "first", Fn: record("first", nil)},
+		{Name: "failing", Fn: record("failing", errors.New("unreachable"))},
+		{Name: "hung", Fn: hang},
+		{Name: "last", Fn: record("last", nil)},
+	}, 10*time.Millisecond)
+
+	gt.A(t, ran).Equal([]string{"first", "failing", "hung", "last"})
+	gt.True(t, errors.Is(hungErr, context.DeadlineExceeded))
+}
+
+func TestWarmupSteps(t *testing.T) {
+	repo := memory.New()
+	t.Cleanup(func() { _ = repo.Close() })
+
+	t.Run("slack enabled", func(t *testing.T) {
+		slackPing := func(context.Context) error { return nil }
+		gt.A(t, cli.WarmupStepNamesForTest(repo, slackPing)).Equal([]string{"repository", "slack"})
+	})
+
+	t.Run("slack disabled", func(t *testing.T) {
+		gt.A(t, cli.WarmupStepNamesForTest(repo, nil)).Equal([]string{"repository"})
+	})
+}
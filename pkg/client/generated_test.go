@@ -0,0 +1,43 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+	"github.com/m-mizutani/shepherd/pkg/client"
+	server "github.com/m-mizutani/shepherd/pkg/controller/http"
+	"github.com/m-mizutani/shepherd/pkg/domain/model"
+	"github.com/m-mizutani/shepherd/pkg/repository/memory"
+	"github.com/m-mizutani/shepherd/pkg/usecase"
+)
+
+// TestClient_AgainstServer guards against the generated client drifting
+// from the server generated from the same openapi.yaml.
+func TestClient_AgainstServer(t *testing.T) {
+	repo := memory.New()
+	t.Cleanup(func() { _ = repo.Close() })
+
+	registry := model.NewWorkspaceRegistry()
+	registry.Register(&model.WorkspaceEntry{
+		Workspace: model.Workspace{ID: "support", Name: "Support Team"},
+	})
+
+	authUC := usecase.NewNoAuthnUseCase("U_TEST", "test@example.com", "Test User")
+	ts := httptest.NewServer(server.New(registry, repo, authUC))
+	defer ts.Close()
+
+	c := gt.R1(client.NewClientWithResponses(ts.URL)).NoError(t)
+	ctx := context.Background()
+
+	health := gt.R1(c.GetHealthWithResponse(ctx)).NoError(t)
+	gt.N(t, health.StatusCode()).Equal(http.StatusOK)
+
+	resp := gt.R1(c.ListWorkspacesWithResponse(ctx)).NoError(t)
+	gt.N(t, resp.StatusCode()).Equal(http.StatusOK)
+	gt.V(t, resp.JSON200).NotNil().Required()
+	gt.A(t, resp.JSON200.Workspaces).Length(1)
+	gt.S(t, resp.JSON200.Workspaces[0].Name).Equal("Support Team")
+}
@@ -240,3 +240,28 @@ func TestAuthLogin_NoAuthn(t *testing.T) {
 	gt.B(t, resp.StatusCode == http.StatusTemporaryRedirect || resp.StatusCode == http.StatusFound).True()
 	gt.S(t, resp.Header.Get("Location")).Equal("/")
 }
+
+func TestAPI_RequiresSession(t *testing.T) {
+	repo := memory.New()
+	t.Cleanup(func() { _ = repo.Close() })
+
+	authUC := usecase.NewAuthUseCase(repo, "client-id", "client-secret", "http://localhost/api/auth/callback")
+	ts := httptest.NewServer(server.New(model.NewWorkspaceRegistry(), repo, authUC))
+	defer ts.Close()
+
+	for _, path := range []string{"/api/v1/ws", "/api/v1/openapi.json"} {
+		resp := doGet(t, ts.URL+path)
+		gt.N(t, resp.StatusCode).Equal(http.StatusUnauthorized)
+
+		req := gt.R1(http.NewRequest(http.MethodGet, ts.URL+path, nil)).NoError(t)
+		req.AddCookie(&http.Cookie{Name: "token_id", Value: "forged"})
+		req.AddCookie(&http.Cookie{Name: "token_secret", Value: "forged"})
+		resp = gt.R1(http.DefaultClient.Do(req)).NoError(t)
+		safe.Close(context.Background(), resp.Body)
+		gt.N(t, resp.StatusCode).Equal(http.StatusUnauthorized)
+	}
+
+	// Probes stay public for orchestrators.
+	resp := doGet(t, ts.URL+"/healthz")
+	gt.N(t, resp.StatusCode).Equal(http.StatusOK)
+}
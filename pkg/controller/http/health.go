@@ -0,0 +1,58 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/m-mizutani/shepherd/pkg/usecase"
+)
+
+// healthCheck is the per-dependency entry of the /readyz response. The
+// endpoint is unauthenticated, so it carries only the status; the error
+// itself goes to the log.
+type healthCheck struct {
+	Status string `json:"status"`
+}
+
+// healthResponse is the body of /healthz and /readyz. Checks is omitted by
+// the liveness probe, which never touches dependencies.
+type healthResponse struct {
+	Status string                 `json:"status"`
+	Checks map[string]healthCheck `json:"checks,omitempty"`
+}
+
+// livenessHandler reports that the process is serving HTTP. It deliberately
+// checks nothing else so that a slow backend cannot get the process
+// restarted.
+func livenessHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(r.Context(), w, http.StatusOK, healthResponse{Status: "ok"})
+	}
+}
+
+// readinessHandler reports each dependency's status from the readiness
+// usecase. It answers 503 when any check fails so that orchestrators stop
+// routing requests to this instance.
+func readinessHandler(readinessUC *usecase.ReadinessUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		report := readinessUC.Check(r.Context())
+
+		resp := healthResponse{
+			Status: "ok",
+			Checks: make(map[string]healthCheck, len(report.Checks)),
+		}
+		for name, ok := range report.Checks {
+			status := "ok"
+			if !ok {
+				status = "error"
+			}
+			resp.Checks[name] = healthCheck{Status: status}
+		}
+
+		statusCode := http.StatusOK
+		if !report.Ready() {
+			resp.Status = "unavailable"
+			statusCode = http.StatusServiceUnavailable
+		}
+		writeJSON(r.Context(), w, statusCode, resp)
+	}
+}
@@ -0,0 +1,98 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+	server "github.com/m-mizutani/shepherd/pkg/controller/http"
+	"github.com/m-mizutani/shepherd/pkg/domain/model"
+	"github.com/m-mizutani/shepherd/pkg/repository/memory"
+	"github.com/m-mizutani/shepherd/pkg/usecase"
+)
+
+// healthResponse mirrors the /healthz and /readyz body.
+type healthResponse struct {
+	Status string `json:"status"`
+	Checks map[string]struct {
+		Status string `json:"status"`
+		Error  string `json:"error"`
+	} `json:"checks"`
+}
+
+type fakeReadinessSlack struct {
+	err error
+}
+
+func (f *fakeReadinessSlack) AuthTest(_ context.Context) error { return f.err }
+
+func TestHealthz(t *testing.T) {
+	ts := setupTestServer(t)
+	defer ts.Close()
+
+	resp := doGet(t, ts.URL+"/healthz")
+	gt.N(t, resp.StatusCode).Equal(http.StatusOK)
+
+	body := decodeJSON[healthResponse](t, resp)
+	gt.S(t, body.Status).Equal("ok")
+}
+
+func TestReadyz(t *testing.T) {
+	ts := setupTestServer(t)
+	defer ts.Close()
+
+	resp := doGet(t, ts.URL+"/readyz")
+	gt.N(t, resp.StatusCode).Equal(http.StatusOK)
+
+	body := decodeJSON[healthResponse](t, resp)
+	gt.S(t, body.Status).Equal("ok")
+	gt.S(t, body.Checks["repository"].Status).Equal("ok")
+	_, hasSlack := body.Checks["slack"]
+	gt.False(t, hasSlack)
+}
+
+func TestReadyz_SlackCheck(t *testing.T) {
+	newServer := func(t *testing.T, slack usecase.ReadinessSlackClient) *httptest.Server {
+		t.Helper()
+		repo := memory.New()
+		t.Cleanup(func() { _ = repo.Close() })
+		authUC := usecase.NewNoAuthnUseCase("U_TEST", "test@example.com", "Test User")
+		srv := server.New(model.NewWorkspaceRegistry(), repo, authUC,
+			server.WithReadiness(usecase.NewReadinessUseCase(repo, slack)))
+		ts := httptest.NewServer(srv)
+		t.Cleanup(ts.Close)
+		return ts
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		ts := newServer(t, &fakeReadinessSlack{})
+
+		resp := doGet(t, ts.URL+"/readyz")
+		gt.N(t, resp.StatusCode).Equal(http.StatusOK)
+
+		body := decodeJSON[healthResponse](t, resp)
+		gt.S(t, body.Checks["slack"].Status).Equal("ok")
+	})
+
+	t.Run("failure does not leak error detail", func(t *testing.T) {
+		ts := newServer(t, &fakeReadinessSlack{err: errors.New("invalid_auth: projects/secret-project")})
+
+		resp := doGet(t, ts.URL+"/readyz")
+		gt.N(t, resp.StatusCode).Equal(http.StatusServiceUnavailable)
+
+		raw := gt.R1(io.ReadAll(resp.Body)).NoError(t)
+		gt.S(t, string(raw)).NotContains("secret-project")
+
+		var body healthResponse
+		gt.NoError(t, json.Unmarshal(raw, &body)).Required()
+		gt.S(t, body.Status).Equal("unavailable")
+		gt.S(t, body.Checks["slack"].Status).Equal("error")
+		gt.S(t, body.Checks["slack"].Error).Equal("")
+		gt.S(t, body.Checks["repository"].Status).Equal("ok")
+	})
+}
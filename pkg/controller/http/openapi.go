@@ -0,0 +1,27 @@
+package http
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/m-mizutani/shepherd/pkg/utils/errutil"
+)
+
+// loadOpenAPISpec decodes the embedded spec once; GetSwagger gunzips and
+// parses the whole document on every call.
+var loadOpenAPISpec = sync.OnceValues(GetSwagger)
+
+// openAPISpecHandler serves the OpenAPI document embedded in generated.go as
+// JSON. Because it is the same document the server interface was generated
+// from, clients built against it always match what this binary implements.
+func openAPISpecHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		spec, err := loadOpenAPISpec()
+		if err != nil {
+			errutil.HandleHTTP(r.Context(), w, goerr.Wrap(err, "failed to load embedded OpenAPI spec"), http.StatusInternalServerError)
+			return
+		}
+		writeJSON(r.Context(), w, http.StatusOK, spec)
+	}
+}
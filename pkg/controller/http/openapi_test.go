@@ -0,0 +1,25 @@
+package http_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+)
+
+func TestOpenAPISpec(t *testing.T) {
+	ts := setupTestServer(t)
+	defer ts.Close()
+
+	resp := doGet(t, ts.URL+"/api/v1/openapi.json")
+	gt.N(t, resp.StatusCode).Equal(http.StatusOK)
+
+	type specResp struct {
+		OpenAPI string         `json:"openapi"`
+		Paths   map[string]any `json:"paths"`
+	}
+	body := decodeJSON[specResp](t, resp)
+	gt.S(t, body.OpenAPI).NotEqual("")
+	_, ok := body.Paths["/api/v1/ws"]
+	gt.True(t, ok)
+}
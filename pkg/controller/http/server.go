@@ -15,6 +15,7 @@ import (
 	"github.com/m-mizutani/shepherd/pkg/usecase"
 	"github.com/m-mizutani/shepherd/pkg/usecase/prompt"
 	"github.com/m-mizutani/shepherd/pkg/usecase/source"
+	"github.com/m-mizutani/shepherd/pkg/utils/async"
 	"github.com/m-mizutani/shepherd/pkg/utils/safe"
 )
 
@@ -25,6 +26,8 @@ type Server struct {
 	catalog  *tool.Catalog
 	promptUC *prompt.UseCase
 	llm      gollem.LLMClient
+
+	readinessUC *usecase.ReadinessUseCase
 }
 
 type ServerOption func(*Server)
@@ -54,12 +57,26 @@ func WithLLM(llm gollem.LLMClient) ServerOption {
 	}
 }
 
+// WithReadiness wires the dependency checks served by /readyz. When
+// omitted, /readyz checks the repository only.
+func WithReadiness(readinessUC *usecase.ReadinessUseCase) ServerOption {
+	return func(s *Server) {
+		s.readinessUC = readinessUC
+	}
+}
+
 type SlackConfig struct {
 	SigningSecret string
 	SlackUC       *usecase.SlackUseCase
 	Notifier      usecase.TicketChangeNotifier
 	TriageUC      TriageInteractionsUC
 	QuickUC       QuickActionsInteractionsUC
+	// EventPool bounds the number of in-flight Events API handlers. When
+	// nil, events are dispatched with the unbounded async.Dispatch.
+	EventPool *async.Pool
+	// RecordDir, when set, is the directory every verified Slack request
+	// is written to for later replay with `shepherd test-webhook`.
+	RecordDir string
 }
 
 func WithSlack(cfg SlackConfig) ServerOption {
@@ -81,6 +98,14 @@ func New(registry *model.WorkspaceRegistry, repo interfaces.Repository, authUC u
 	s.mux.Use(middleware.RealIP)
 	s.mux.Use(httpLogger)
 
+	// Orchestrator probes (no auth middleware)
+	s.mux.Get("/healthz", livenessHandler())
+	readinessUC := s.readinessUC
+	if readinessUC == nil {
+		readinessUC = usecase.NewReadinessUseCase(repo, nil)
+	}
+	s.mux.Get("/readyz", readinessHandler(readinessUC))
+
 	// Auth endpoints (no auth middleware)
 	s.mux.Route("/api/auth", func(r chi.Router) {
 		r.Get("/login", authLoginHandler(authUC))
@@ -99,6 +124,7 @@ func New(registry *model.WorkspaceRegistry, repo interfaces.Repository, authUC u
 	apiHandler := NewAPIHandler(registry, repo, notifier, s.llm, slackUC, s.sourceUC, s.catalog, s.promptUC)
 	s.mux.Group(func(r chi.Router) {
 		r.Use(authMiddleware(authUC))
+		r.Get("/api/v1/openapi.json", openAPISpecHandler())
 		HandlerFromMux(apiHandler, r)
 	})
 
@@ -106,7 +132,10 @@ func New(registry *model.WorkspaceRegistry, repo interfaces.Repository, authUC u
 	if s.slackCfg != nil {
 		s.mux.Route("/hooks/slack", func(r chi.Router) {
 			r.Use(slackSignatureMiddleware(s.slackCfg.SigningSecret))
-			r.Post("/event", slackEventHandler(s.slackCfg.SlackUC))
+			if s.slackCfg.RecordDir != "" {
+				r.Use(slackRecordMiddleware(s.slackCfg.RecordDir))
+			}
+			r.Post("/event", slackEventHandler(s.slackCfg.SlackUC, s.slackCfg.EventPool))
 			if s.slackCfg.TriageUC != nil || s.slackCfg.QuickUC != nil {
 				r.Post("/interaction", slackInteractionsHandler(s.slackCfg.TriageUC, s.slackCfg.QuickUC))
 			}
@@ -17,7 +17,33 @@ import (
 	"github.com/slack-go/slack/slackevents"
 )
 
-func slackEventHandler(slackUC *usecase.SlackUseCase) http.HandlerFunc {
+// slackEventRetryAfter is the Retry-After hint sent with a 503 when the event
+// pool is saturated. Slack applies its own redelivery schedule, so this is
+// advisory only.
+const slackEventRetryAfter = "30"
+
+// dispatchSlackEvent runs fn on pool when one is configured, falling back to
+// the unbounded async.Dispatch otherwise. When the pool rejects fn it writes
+// a 503 with Retry-After so Slack redelivers the event, and returns false;
+// the caller must not write a response in that case.
+func dispatchSlackEvent(w http.ResponseWriter, r *http.Request, pool *async.Pool, fn func(ctx context.Context) error) bool {
+	if pool == nil {
+		async.Dispatch(r.Context(), fn)
+		return true
+	}
+
+	if err := pool.Submit(r.Context(), fn); err != nil {
+		logging.From(r.Context()).Warn("slack event rejected: event pool is saturated",
+			slog.Any("error", err),
+		)
+		w.Header().Set("Retry-After", slackEventRetryAfter)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return false
+	}
+	return true
+}
+
+func slackEventHandler(slackUC *usecase.SlackUseCase, pool *async.Pool) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		body, err := io.ReadAll(r.Body)
 		if err != nil {
@@ -74,9 +100,11 @@ func slackEventHandler(slackUC *usecase.SlackUseCase) http.HandlerFunc {
 					w.WriteHeader(http.StatusOK)
 					return
 				}
-				async.Dispatch(r.Context(), func(ctx context.Context) error {
+				if !dispatchSlackEvent(w, r, pool, func(ctx context.Context) error {
 					return slackUC.HandleAppMention(ctx, ev.Channel, ev.User, ev.Text, ev.TimeStamp, ev.ThreadTimeStamp)
-				})
+				}) {
+					return
+				}
 
 			case *slackevents.MessageEvent:
 				if slackUC == nil {
@@ -97,9 +125,11 @@ func slackEventHandler(slackUC *usecase.SlackUseCase) http.HandlerFunc {
 				switch ev.SubType {
 				case "message_changed":
 					if ev.Message != nil {
-						async.Dispatch(r.Context(), func(ctx context.Context) error {
+						if !dispatchSlackEvent(w, r, pool, func(ctx context.Context) error {
 							return slackUC.HandleMessageChanged(ctx, ev.Channel, ev.Message.Timestamp, ev.Message.Text)
-						})
+						}) {
+							return
+						}
 					}
 				case "":
 					isBot := ev.BotID != ""
@@ -109,13 +139,17 @@ func slackEventHandler(slackUC *usecase.SlackUseCase) http.HandlerFunc {
 							w.WriteHeader(http.StatusOK)
 							return
 						}
-						async.Dispatch(r.Context(), func(ctx context.Context) error {
+						if !dispatchSlackEvent(w, r, pool, func(ctx context.Context) error {
 							return slackUC.HandleNewMessage(ctx, ev.Channel, ev.User, ev.Text, ev.TimeStamp)
-						})
+						}) {
+							return
+						}
 					} else {
-						async.Dispatch(r.Context(), func(ctx context.Context) error {
+						if !dispatchSlackEvent(w, r, pool, func(ctx context.Context) error {
 							return slackUC.HandleThreadReply(ctx, ev.Channel, ev.ThreadTimeStamp, ev.User, ev.Text, ev.TimeStamp, isBot)
-						})
+						}) {
+							return
+						}
 					}
 				default:
 					logger.Debug("slack message subtype skipped",
@@ -0,0 +1,83 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/m-mizutani/shepherd/pkg/utils/errutil"
+)
+
+// SlackRecording is one Slack webhook request as written by the payload
+// recorder. Body is kept verbatim (JSON for events, form-encoded for
+// interactions) so `shepherd test-webhook` can re-sign and replay it
+// against Path.
+type SlackRecording struct {
+	ReceivedAt time.Time   `json:"received_at"`
+	Path       string      `json:"path"`
+	Header     http.Header `json:"header"`
+	Body       string      `json:"body"`
+}
+
+// slackRecordMiddleware writes every verified Slack request into dir as a
+// SlackRecording. It sits behind slackSignatureMiddleware so forged requests
+// are never persisted. Recording failures are reported but never fail the
+// webhook: Slack would otherwise redeliver the event.
+func slackRecordMiddleware(dir string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				errutil.HandleHTTP(r.Context(), w, goerr.Wrap(err, "failed to read body"), http.StatusBadRequest)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewBuffer(body))
+
+			rec := SlackRecording{
+				ReceivedAt: time.Now().UTC(),
+				Path:       r.URL.Path,
+				Header:     r.Header.Clone(),
+				Body:       string(body),
+			}
+			if err := writeSlackRecording(dir, &rec); err != nil {
+				errutil.Handle(r.Context(), err)
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func writeSlackRecording(dir string, rec *SlackRecording) error {
+	// Names sort chronologically and carry the endpoint, e.g.
+	// 20260102T150405.000000000Z-<uuid>-event.json. The UUID keeps two
+	// requests received in the same nanosecond from colliding on O_EXCL.
+	name := rec.ReceivedAt.Format("20060102T150405.000000000Z") + "-" + uuid.NewString() + "-" + path.Base(rec.Path) + ".json"
+	fpath := filepath.Join(dir, name)
+
+	data, err := json.MarshalIndent(rec, "", "  ")
+	if err != nil {
+		return goerr.Wrap(err, "failed to marshal slack recording")
+	}
+
+	// Payloads carry message text from Slack, so keep them owner-only.
+	f, err := os.OpenFile(fpath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600) // #nosec G304 -- name is derived from a timestamp, a UUID and a fixed route
+	if err != nil {
+		return goerr.Wrap(err, "failed to create slack recording", goerr.V("path", fpath))
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return goerr.Wrap(err, "failed to write slack recording", goerr.V("path", fpath))
+	}
+	if err := f.Close(); err != nil {
+		return goerr.Wrap(err, "failed to close slack recording", goerr.V("path", fpath))
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package http_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	server "github.com/m-mizutani/shepherd/pkg/controller/http"
+	"github.com/m-mizutani/shepherd/pkg/domain/model"
+	"github.com/m-mizutani/shepherd/pkg/repository/memory"
+	"github.com/m-mizutani/shepherd/pkg/usecase"
+	"github.com/m-mizutani/shepherd/pkg/utils/safe"
+)
+
+const testSigningSecret = "test-signing-secret"
+
+func setupRecordingServer(t *testing.T, dir string) *httptest.Server {
+	t.Helper()
+
+	repo := memory.New()
+	t.Cleanup(func() { _ = repo.Close() })
+
+	authUC := usecase.NewNoAuthnUseCase("U_TEST", "test@example.com", "Test User")
+	srv := server.New(model.NewWorkspaceRegistry(), repo, authUC, server.WithSlack(server.SlackConfig{
+		SigningSecret: testSigningSecret,
+		RecordDir:     dir,
+	}))
+	ts := httptest.NewServer(srv)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func postSlackEvent(t *testing.T, url, secret, body string) *http.Response {
+	t.Helper()
+
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write([]byte("v0:" + ts + ":" + body))
+
+	req := gt.R1(http.NewRequest(http.MethodPost, url, strings.NewReader(body))).NoError(t)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Slack-Request-Timestamp", ts)
+	req.Header.Set("X-Slack-Signature", "v0="+hex.EncodeToString(mac.Sum(nil)))
+
+	resp := gt.R1(http.DefaultClient.Do(req)).NoError(t)
+	t.Cleanup(func() { safe.Close(context.Background(), resp.Body) })
+	return resp
+}
+
+func TestSlackRecord(t *testing.T) {
+	dir := t.TempDir()
+	ts := setupRecordingServer(t, dir)
+
+	body := `{"type":"url_verification","challenge":"abc123"}`
+	resp := postSlackEvent(t, ts.URL+"/hooks/slack/event", testSigningSecret, body)
+	gt.N(t, resp.StatusCode).Equal(http.StatusOK)
+	gt.S(t, string(gt.R1(io.ReadAll(resp.Body)).NoError(t))).Equal("abc123")
+
+	entries := gt.R1(os.ReadDir(dir)).NoError(t)
+	gt.A(t, entries).Length(1)
+	gt.True(t, strings.HasSuffix(entries[0].Name(), "-event.json"))
+
+	data := gt.R1(os.ReadFile(filepath.Join(dir, entries[0].Name()))).NoError(t)
+	var rec server.SlackRecording
+	gt.NoError(t, json.Unmarshal(data, &rec)).Required()
+	gt.S(t, rec.Path).Equal("/hooks/slack/event")
+	gt.S(t, rec.Body).Equal(body)
+	gt.S(t, rec.Header.Get("Content-Type")).Equal("application/json")
+	gt.S(t, rec.Header.Get("X-Slack-Signature")).NotEqual("")
+}
+
+func TestSlackRecord_RejectedRequestNotRecorded(t *testing.T) {
+	dir := t.TempDir()
+	ts := setupRecordingServer(t, dir)
+
+	body := `{"type":"url_verification","challenge":"abc123"}`
+	resp := postSlackEvent(t, ts.URL+"/hooks/slack/event", "wrong-secret", body)
+	gt.N(t, resp.StatusCode).Equal(http.StatusUnauthorized)
+
+	entries := gt.R1(os.ReadDir(dir)).NoError(t)
+	gt.A(t, entries).Length(0)
+}
+
+func TestSlackRecord_EachRequestGetsOwnFile(t *testing.T) {
+	dir := t.TempDir()
+	ts := setupRecordingServer(t, dir)
+
+	body := `{"type":"url_verification","challenge":"abc123"}`
+	for range 3 {
+		resp := postSlackEvent(t, ts.URL+"/hooks/slack/event", testSigningSecret, body)
+		gt.N(t, resp.StatusCode).Equal(http.StatusOK)
+	}
+
+	entries := gt.R1(os.ReadDir(dir)).NoError(t)
+	gt.A(t, entries).Length(3)
+	for _, e := range entries {
+		gt.True(t, strings.HasSuffix(e.Name(), "-event.json"))
+	}
+}
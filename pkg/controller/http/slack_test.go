@@ -0,0 +1,51 @@
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+	server "github.com/m-mizutani/shepherd/pkg/controller/http"
+	"github.com/m-mizutani/shepherd/pkg/domain/model"
+	"github.com/m-mizutani/shepherd/pkg/repository/memory"
+	"github.com/m-mizutani/shepherd/pkg/usecase"
+	"github.com/m-mizutani/shepherd/pkg/utils/async"
+)
+
+func TestSlackEvent_PoolSaturated(t *testing.T) {
+	repo := memory.New()
+	t.Cleanup(func() { _ = repo.Close() })
+	registry := model.NewWorkspaceRegistry()
+
+	pool := async.NewPool(1, 1)
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer func() {
+		close(release)
+		pool.Close()
+	}()
+	// Occupy the only worker, then fill the single queue slot.
+	gt.NoError(t, pool.Submit(context.Background(), func(ctx context.Context) error {
+		close(started)
+		<-release
+		return nil
+	}))
+	<-started
+	gt.NoError(t, pool.Submit(context.Background(), func(ctx context.Context) error { return nil }))
+
+	authUC := usecase.NewNoAuthnUseCase("U_TEST", "test@example.com", "Test User")
+	srv := server.New(registry, repo, authUC, server.WithSlack(server.SlackConfig{
+		SigningSecret: testSigningSecret,
+		SlackUC:       usecase.NewSlackUseCase(repo, registry, nil, "", nil, nil, nil),
+		EventPool:     pool,
+	}))
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	body := `{"type":"event_callback","event":{"type":"message","channel":"C123","user":"U123","text":"help","ts":"1700000000.000100"}}`
+	resp := postSlackEvent(t, ts.URL+"/hooks/slack/event", testSigningSecret, body)
+	gt.N(t, resp.StatusCode).Equal(http.StatusServiceUnavailable)
+	gt.S(t, resp.Header.Get("Retry-After")).Equal("30")
+}
@@ -19,6 +19,9 @@ type Repository interface {
 	PutToken(ctx context.Context, token *auth.Token) error
 	GetToken(ctx context.Context, tokenID auth.TokenID) (*auth.Token, error)
 	DeleteToken(ctx context.Context, tokenID auth.TokenID) error
+	// Ping verifies the backend is reachable. It is used by the readiness
+	// probe and must be cheap enough to run on every probe.
+	Ping(ctx context.Context) error
 	Close() error
 }
 
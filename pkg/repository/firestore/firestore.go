@@ -7,6 +7,7 @@ import (
 	"github.com/m-mizutani/goerr/v2"
 	"github.com/m-mizutani/shepherd/pkg/domain/interfaces"
 	"github.com/m-mizutani/shepherd/pkg/domain/model/auth"
+	"google.golang.org/api/iterator"
 )
 
 type Repository struct {
@@ -122,6 +123,17 @@ func (r *Repository) DeleteToken(ctx context.Context, tokenID auth.TokenID) erro
 	return nil
 }
 
+// Ping reads at most one auth token document. An empty collection is fine;
+// only transport and permission failures are reported.
+func (r *Repository) Ping(ctx context.Context) error {
+	iter := r.client.Collection("auth_tokens").Limit(1).Documents(ctx)
+	defer iter.Stop()
+	if _, err := iter.Next(); err != nil && err != iterator.Done {
+		return goerr.Wrap(err, "failed to ping firestore")
+	}
+	return nil
+}
+
 func (r *Repository) Close() error {
 	return r.client.Close()
 }
@@ -66,4 +66,6 @@ func (r *Repository) DeleteToken(ctx context.Context, tokenID auth.TokenID) erro
 	return nil
 }
 
+func (r *Repository) Ping(ctx context.Context) error { return nil }
+
 func (r *Repository) Close() error { return nil }
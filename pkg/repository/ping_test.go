@@ -0,0 +1,15 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+	"github.com/m-mizutani/shepherd/pkg/domain/interfaces"
+)
+
+func TestPing(t *testing.T) {
+	runTest(t, "Ping", func(t *testing.T, repo interfaces.Repository) {
+		gt.NoError(t, repo.Ping(context.Background()))
+	})
+}
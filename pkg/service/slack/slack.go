@@ -129,6 +129,17 @@ func (c *Client) PostEphemeral(ctx context.Context, channelID, userID, text stri
 	return nil
 }
 
+// AuthTest calls auth.test to check that the bot token is valid. It is
+// cheap and side-effect free, so it doubles as a connectivity probe.
+func (c *Client) AuthTest(ctx context.Context) error {
+	if _, err := c.api.AuthTestContext(ctx); err != nil {
+		return goerr.Wrap(err, "failed to call slack auth.test",
+			goerr.Tag(errutil.TagSlackError),
+		)
+	}
+	return nil
+}
+
 type UserInfo struct {
 	ID       string
 	Name     string
@@ -58,10 +58,10 @@ func (t *getTool) Run(ctx context.Context, args map[string]any) (map[string]any,
 	}
 	for _, tk := range tickets {
 		if ticketID != "" && string(tk.ID) == ticketID {
-			return map[string]any{"found": true, "ticket": format.Ticket(tk, "")}, nil
+			return map[string]any{"found": true, "ticket": format.Ticket(redactTicket(ctx, tk), "")}, nil
 		}
 		if hasSeq && tk.SeqNum == seqNum {
-			return map[string]any{"found": true, "ticket": format.Ticket(tk, "")}, nil
+			return map[string]any{"found": true, "ticket": format.Ticket(redactTicket(ctx, tk), "")}, nil
 		}
 	}
 	return map[string]any{"found": false}, nil
@@ -68,7 +68,7 @@ func (t *getCommentsTool) Run(ctx context.Context, args map[string]any) (map[str
 
 	out := make([]map[string]any, 0, len(comments))
 	for _, c := range comments {
-		out = append(out, format.Comment(c, string(c.SlackUserID)))
+		out = append(out, format.Comment(redactComment(ctx, c), string(c.SlackUserID)))
 	}
 	return map[string]any{
 		"comments": out,
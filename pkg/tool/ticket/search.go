@@ -87,7 +87,7 @@ func (t *searchTool) Run(ctx context.Context, args map[string]any) (map[string]a
 
 	out := make([]map[string]any, 0, len(matched))
 	for _, tk := range matched {
-		out = append(out, format.TicketSummary(tk, ""))
+		out = append(out, format.TicketSummary(redactTicket(ctx, tk), ""))
 	}
 	return map[string]any{
 		"tickets": out,
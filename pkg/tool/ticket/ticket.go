@@ -10,8 +10,10 @@ import (
 	"github.com/m-mizutani/goerr/v2"
 	"github.com/m-mizutani/gollem"
 	"github.com/m-mizutani/shepherd/pkg/domain/interfaces"
+	"github.com/m-mizutani/shepherd/pkg/domain/model"
 	"github.com/m-mizutani/shepherd/pkg/domain/types"
 	"github.com/m-mizutani/shepherd/pkg/tool"
+	"github.com/m-mizutani/shepherd/pkg/usecase/prompt"
 	"github.com/urfave/cli/v3"
 )
 
@@ -55,3 +57,22 @@ func workspaceFromCtx(ctx context.Context) (types.WorkspaceID, error) {
 	}
 	return id, nil
 }
+
+// redactTicket returns a copy of t with its free-text fields passed through
+// prompt.RedactForLLM. Tool results go straight back to the model, so they
+// need the same treatment as the ticket text in the system prompt; the
+// stored ticket is left untouched.
+func redactTicket(ctx context.Context, t *model.Ticket) *model.Ticket {
+	redacted := *t
+	redacted.Title = prompt.RedactForLLM(ctx, "ticket.title", t.Title)
+	redacted.Description = prompt.RedactForLLM(ctx, "ticket.description", t.Description)
+	redacted.InitialMessage = prompt.RedactForLLM(ctx, "ticket.initial_message", t.InitialMessage)
+	return &redacted
+}
+
+// redactComment is the comment counterpart of redactTicket.
+func redactComment(ctx context.Context, c *model.Comment) *model.Comment {
+	redacted := *c
+	redacted.Body = prompt.RedactForLLM(ctx, "comment.body", c.Body)
+	return &redacted
+}
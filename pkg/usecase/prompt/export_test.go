@@ -0,0 +1,3 @@
+package prompt
+
+var RedactForTest = redact
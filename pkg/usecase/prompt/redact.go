@@ -0,0 +1,83 @@
+package prompt
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+
+	"github.com/m-mizutani/shepherd/pkg/utils/logging"
+)
+
+// secretPattern is one kind of credential redact strips before text is sent
+// to the LLM provider. Kind is what shows up in the replacement marker and
+// in debug logs; the matched text itself is never logged.
+//
+// repl is a regexp.Expand template for patterns that must keep part of the
+// match (e.g. the scheme and host around URL credentials). When empty, the
+// whole match is replaced by the marker.
+type secretPattern struct {
+	kind string
+	re   *regexp.Regexp
+	repl string
+}
+
+func (p secretPattern) replacement() string {
+	if p.repl != "" {
+		return p.repl
+	}
+	return redactedMarker(p.kind)
+}
+
+func redactedMarker(kind string) string {
+	return "[REDACTED:" + kind + "]"
+}
+
+var secretPatterns = []secretPattern{
+	{kind: "private_key", re: regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`)},
+	{kind: "url_credentials", re: regexp.MustCompile(`([a-zA-Z][a-zA-Z0-9+.\-]*://)[^\s/:@]+:[^\s/@]+@`), repl: "${1}" + redactedMarker("url_credentials") + "@"},
+	{kind: "slack_token", re: regexp.MustCompile(`\bxox[abeoprs]-[0-9A-Za-z\-]{10,}`)},
+	{kind: "github_token", re: regexp.MustCompile(`\b(?:gh[pousr]_[0-9A-Za-z]{36,}|github_pat_[0-9A-Za-z_]{22,})`)},
+	{kind: "aws_access_key", re: regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{kind: "google_api_key", re: regexp.MustCompile(`\bAIza[0-9A-Za-z_\-]{35}`)},
+	{kind: "api_key", re: regexp.MustCompile(`\bsk-[0-9A-Za-z_\-]{20,}`)},
+	{kind: "jwt", re: regexp.MustCompile(`\beyJ[0-9A-Za-z_\-]+\.eyJ[0-9A-Za-z_\-]+\.[0-9A-Za-z_\-]+`)},
+	{kind: "bearer_token", re: regexp.MustCompile(`(?i)(\bbearer\s+)[0-9A-Za-z._~+/\-]{20,}=*`), repl: "${1}" + redactedMarker("bearer_token")},
+}
+
+// redact replaces credentials found in text with "[REDACTED:<kind>]"
+// markers and returns the result together with the number of replacements
+// per kind. Patterns are applied in declaration order, so broader shapes
+// (private key blocks, URLs with userinfo) are consumed before the
+// token-level patterns get a chance to match inside them.
+func redact(text string) (string, map[string]int) {
+	var counts map[string]int
+	for _, p := range secretPatterns {
+		n := len(p.re.FindAllStringIndex(text, -1))
+		if n == 0 {
+			continue
+		}
+		if counts == nil {
+			counts = make(map[string]int)
+		}
+		counts[p.kind] += n
+		text = p.re.ReplaceAllString(text, p.replacement())
+	}
+	return text, counts
+}
+
+// RedactForLLM runs redact over a value that is about to leave the service
+// as part of an LLM request, and reports what was removed at debug level.
+// field names the value being redacted (e.g. "ticket.description") so the
+// log line can be traced back without including any of the matched text.
+func RedactForLLM(ctx context.Context, field, text string) string {
+	redacted, counts := redact(text)
+	if len(counts) > 0 {
+		attrs := make([]any, 0, len(counts)+1)
+		attrs = append(attrs, slog.String("field", field))
+		for kind, n := range counts {
+			attrs = append(attrs, slog.Int(kind, n))
+		}
+		logging.From(ctx).Debug("redacted secrets from LLM input", attrs...)
+	}
+	return redacted
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/m-mizutani/shepherd/pkg/domain/interfaces"
+	"github.com/m-mizutani/shepherd/pkg/utils/logging"
+)
+
+// readinessCheckTimeout bounds each dependency check so a hung backend
+// fails the probe instead of stalling it past the orchestrator's deadline.
+const readinessCheckTimeout = 5 * time.Second
+
+// ReadinessSlackClient is the part of the Slack service the readiness check
+// calls. auth.test confirms the API still accepts the bot token.
+type ReadinessSlackClient interface {
+	AuthTest(ctx context.Context) error
+}
+
+// ReadinessUseCase checks every dependency needed to serve traffic.
+type ReadinessUseCase struct {
+	checks map[string]func(ctx context.Context) error
+}
+
+// NewReadinessUseCase builds the checks for repo and, when the Slack
+// integration is enabled, slack. Pass nil for slack when it is disabled.
+func NewReadinessUseCase(repo interfaces.Repository, slack ReadinessSlackClient) *ReadinessUseCase {
+	checks := map[string]func(ctx context.Context) error{
+		"repository": repo.Ping,
+	}
+	if slack != nil {
+		checks["slack"] = slack.AuthTest
+	}
+	return &ReadinessUseCase{checks: checks}
+}
+
+// ReadinessReport is the outcome of one readiness check. Checks maps each
+// dependency name to whether it answered; failure details are only logged,
+// since the report is served to unauthenticated callers.
+type ReadinessReport struct {
+	Checks map[string]bool
+}
+
+// Ready reports whether every dependency answered.
+func (r *ReadinessReport) Ready() bool {
+	for _, ok := range r.Checks {
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Check runs every dependency check and logs each failure with its error.
+func (uc *ReadinessUseCase) Check(ctx context.Context) *ReadinessReport {
+	report := &ReadinessReport{Checks: make(map[string]bool, len(uc.checks))}
+	for name, check := range uc.checks {
+		checkCtx, cancel := context.WithTimeout(ctx, readinessCheckTimeout)
+		err := check(checkCtx)
+		cancel()
+
+		if err != nil {
+			logging.From(ctx).Warn("readiness check failed",
+				slog.String("check", name),
+				slog.Any("error", err),
+			)
+		}
+		report.Checks[name] = err == nil
+	}
+	return report
+}
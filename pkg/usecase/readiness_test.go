@@ -0,0 +1,73 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+	"github.com/m-mizutani/shepherd/pkg/domain/interfaces"
+	"github.com/m-mizutani/shepherd/pkg/repository/memory"
+	"github.com/m-mizutani/shepherd/pkg/usecase"
+)
+
+type fakeReadinessSlack struct {
+	calls int
+	err   error
+}
+
+func (f *fakeReadinessSlack) AuthTest(_ context.Context) error {
+	f.calls++
+	return f.err
+}
+
+// unreachableRepo fails Ping while delegating everything else to memory.
+type unreachableRepo struct {
+	interfaces.Repository
+}
+
+func (r *unreachableRepo) Ping(_ context.Context) error {
+	return errors.New("firestore: connection refused")
+}
+
+func TestReadinessUseCase_AllOK(t *testing.T) {
+	slack := &fakeReadinessSlack{}
+	uc := usecase.NewReadinessUseCase(memory.New(), slack)
+
+	report := uc.Check(context.Background())
+	gt.True(t, report.Ready())
+	gt.True(t, report.Checks["repository"])
+	gt.True(t, report.Checks["slack"])
+	gt.N(t, slack.calls).Equal(1)
+}
+
+func TestReadinessUseCase_SlackDisabled(t *testing.T) {
+	uc := usecase.NewReadinessUseCase(memory.New(), nil)
+
+	report := uc.Check(context.Background())
+	gt.True(t, report.Ready())
+	gt.N(t, len(report.Checks)).Equal(1)
+	gt.True(t, report.Checks["repository"])
+}
+
+func TestReadinessUseCase_SlackFailure(t *testing.T) {
+	slack := &fakeReadinessSlack{err: errors.New("invalid_auth")}
+	uc := usecase.NewReadinessUseCase(memory.New(), slack)
+
+	report := uc.Check(context.Background())
+	gt.False(t, report.Ready())
+	gt.True(t, report.Checks["repository"])
+	gt.False(t, report.Checks["slack"])
+}
+
+func TestReadinessUseCase_RepositoryFailure(t *testing.T) {
+	slack := &fakeReadinessSlack{}
+	uc := usecase.NewReadinessUseCase(&unreachableRepo{Repository: memory.New()}, slack)
+
+	report := uc.Check(context.Background())
+	gt.False(t, report.Ready())
+	gt.False(t, report.Checks["repository"])
+	// A failing repository does not stop the other checks.
+	gt.True(t, report.Checks["slack"])
+	gt.N(t, slack.calls).Equal(1)
+}
@@ -274,9 +274,9 @@ func (uc *SlackUseCase) HandleAppMention(ctx context.Context, channelID, userID,
 	}
 
 	systemPrompt, err := prompt.RenderSystem(prompt.SystemInput{
-		Title:          ticket.Title,
-		Description:    ticket.Description,
-		InitialMessage: ticket.InitialMessage,
+		Title:          prompt.RedactForLLM(ctx, "ticket.title", ticket.Title),
+		Description:    prompt.RedactForLLM(ctx, "ticket.description", ticket.Description),
+		InitialMessage: prompt.RedactForLLM(ctx, "ticket.initial_message", ticket.InitialMessage),
 	})
 	if err != nil {
 		return goerr.Wrap(err, "failed to render system prompt")
@@ -285,7 +285,7 @@ func (uc *SlackUseCase) HandleAppMention(ctx context.Context, channelID, userID,
 	mentionAuthor := uc.resolveDisplayName(ctx, userID)
 	userPrompt, err := prompt.RenderMention(prompt.MentionInput{
 		MentionAuthor: mentionAuthor,
-		Mention:       body,
+		Mention:       prompt.RedactForLLM(ctx, "mention", body),
 	})
 	if err != nil {
 		return goerr.Wrap(err, "failed to render mention prompt")
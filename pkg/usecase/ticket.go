@@ -331,12 +331,15 @@ const conclusionRetryCap = 3
 // model so it can self-correct on the same gollem session.
 func buildConclusion(ctx context.Context, llm gollem.LLMClient, ticket *model.Ticket, comments []*model.Comment) (string, error) {
 	in := prompt.ConclusionInput{
-		Title:          ticket.Title,
-		Description:    ticket.Description,
-		InitialMessage: ticket.InitialMessage,
+		Title:          prompt.RedactForLLM(ctx, "ticket.title", ticket.Title),
+		Description:    prompt.RedactForLLM(ctx, "ticket.description", ticket.Description),
+		InitialMessage: prompt.RedactForLLM(ctx, "ticket.initial_message", ticket.InitialMessage),
 		Comments:       conclusionComments(comments),
 		Language:       conclusionLanguage(ctx),
 	}
+	for i := range in.Comments {
+		in.Comments[i].Body = prompt.RedactForLLM(ctx, "comment.body", in.Comments[i].Body)
+	}
 	systemPrompt, err := prompt.RenderConclusion(in)
 	if err != nil {
 		return "", goerr.Wrap(err, "render conclusion prompt")
@@ -165,10 +165,11 @@ func NewPlanExecutor(repo interfaces.Repository, historyRepo gollem.HistoryRepos
 }
 
 // Run drives the planner loop for a single ticket from whatever state the
-// agent history is currently in. It is the single entry point invoked by
-// async.Dispatch from both the new-ticket trigger (Entry-1) and the submit
-// resume (Entry-2). The function returns nil on natural pauses
-// (waiting_user_submit, done, aborted).
+// agent history is currently in. It is the single entry point scheduled by
+// UseCase.dispatchRun from the new-ticket trigger (Entry-1), the submit
+// resume (Entry-2), the retry button and review re-investigation. The
+// function returns nil on natural pauses (waiting_user_submit, done,
+// aborted).
 func (e *PlanExecutor) run(ctx context.Context, workspaceID types.WorkspaceID, ticketID types.TicketID) (retErr error) {
 	logger := logging.From(ctx).With(
 		slog.String("workspace_id", string(workspaceID)),
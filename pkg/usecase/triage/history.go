@@ -23,6 +23,7 @@ import (
 	"github.com/m-mizutani/gollem"
 	"github.com/m-mizutani/shepherd/pkg/domain/model"
 	"github.com/m-mizutani/shepherd/pkg/domain/types"
+	"github.com/m-mizutani/shepherd/pkg/usecase/prompt"
 )
 
 // planSessionID returns the gollem history session identifier for a ticket's
@@ -40,7 +41,9 @@ func subtaskSessionID(workspaceID types.WorkspaceID, ticketID types.TicketID, su
 // appendUserMessage appends a user-role text message to the plan-level history
 // for the given ticket. This is how triage feeds investigation results and
 // reporter answers back into the LLM context: the next llmPlan call sees
-// these messages naturally as conversation history.
+// these messages naturally as conversation history. The text is redacted
+// before it is stored, since everything in this history is replayed to the
+// LLM provider on every later turn.
 func appendUserMessage(ctx context.Context, repo gollem.HistoryRepository, workspaceID types.WorkspaceID, ticketID types.TicketID, text string) error {
 	sid := planSessionID(workspaceID, ticketID)
 	h, err := repo.Load(ctx, sid)
@@ -51,7 +54,7 @@ func appendUserMessage(ctx context.Context, repo gollem.HistoryRepository, works
 		h = &gollem.History{Version: gollem.HistoryVersion}
 	}
 
-	content, err := gollem.NewTextContent(text)
+	content, err := gollem.NewTextContent(prompt.RedactForLLM(ctx, "triage_history", text))
 	if err != nil {
 		return goerr.Wrap(err, "build text content")
 	}
@@ -44,9 +44,9 @@ const dateAutoFillLayout = "2006-01-02"
 func (e *PlanExecutor) llmPlan(ctx context.Context, ticket *model.Ticket) (*model.TriagePlan, error) {
 	autoFill := e.autoFillFields(ticket.WorkspaceID)
 	in := prompt.TriagePlanInput{
-		Title:          ticket.Title,
-		Description:    ticket.Description,
-		InitialMessage: ticket.InitialMessage,
+		Title:          prompt.RedactForLLM(ctx, "ticket.title", ticket.Title),
+		Description:    prompt.RedactForLLM(ctx, "ticket.description", ticket.Description),
+		InitialMessage: prompt.RedactForLLM(ctx, "ticket.initial_message", ticket.InitialMessage),
 		Reporter:       string(ticket.ReporterSlackUserID),
 		AutoFillFields: autoFillBriefing(autoFill),
 		AvailableTools: e.availableToolBriefings(ctx, ticket.WorkspaceID),
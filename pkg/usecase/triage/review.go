@@ -261,10 +261,7 @@ func (u *UseCase) HandleReviewReinvestigate(ctx context.Context, ticketID types.
 		logger.Warn("failed to post reinvestigating follow-up", slog.String("error", err.Error()))
 	}
 
-	tkID := ticketID
-	async.Dispatch(ctx, func(ctx context.Context) error {
-		return u.executor.run(ctx, wsID, tkID)
-	})
+	u.dispatchRun(ctx, wsID, ticket)
 	return nil
 }
 
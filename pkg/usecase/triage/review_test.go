@@ -191,3 +191,34 @@ func containsString(haystack, needle string) bool {
 		return false
 	})()
 }
+
+// TestHandleReviewReinvestigate_PoolFull_PostsRetry checks that a
+// re-investigation goes through the triage pool like every other planner
+// run: when the pool is saturated, the LLM is not started and the reporter
+// gets the retry button.
+func TestHandleReviewReinvestigate_PoolFull_PostsRetry(t *testing.T) {
+	llm := &mock.LLMClientMock{
+		NewSessionFunc: func(_ context.Context, _ ...gollem.SessionOption) (gollem.Session, error) {
+			t.Fatalf("LLM must not be invoked when the triage pool rejects the run")
+			return nil, nil
+		},
+	}
+	_, exec, repo, hist, slack := newRig(t, llm)
+	ticket := mustCreateTicket(t, repo, false)
+	seedReviewProposal(t, hist, ticket.ID)
+
+	uc := triage.NewUseCase(exec, &fakeResolver{ws: tWS, channel: tChannel}, triage.WithPool(saturatedPool(t)))
+
+	state := &slackgo.ViewState{Values: map[string]map[string]slackgo.BlockAction{
+		slackService.TriageReviewInstructionBlock: {
+			slackService.TriageReviewInstructionAction: {Value: "Look at the auth service logs"},
+		},
+	}}
+	// The rejection is reported synchronously. Don't async.Wait here: the
+	// blocked pool worker is tracked by the same WaitGroup.
+	gt.NoError(t, uc.HandleReviewReinvestigate(context.Background(), ticket.ID, tChannel, "1234.5678", "Uactor", state))
+
+	// The "Re-investigating…" follow-up, then the failure message with retry.
+	gt.A(t, slack.posts).Length(2)
+	gt.True(t, containsString(blockJSON(t, slack.posts[1].blocks), slackService.TriageRetryActionID))
+}
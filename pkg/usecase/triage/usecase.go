@@ -23,6 +23,19 @@ import (
 type UseCase struct {
 	executor *PlanExecutor
 	registry ChannelResolver
+	pool     *async.Pool
+}
+
+// UseCaseOption customises a UseCase built by NewUseCase.
+type UseCaseOption func(*UseCase)
+
+// WithPool runs planner loops on pool instead of the unbounded
+// async.Dispatch, capping how many triage runs (and therefore concurrent
+// LLM sessions) are in flight at once.
+func WithPool(pool *async.Pool) UseCaseOption {
+	return func(u *UseCase) {
+		u.pool = pool
+	}
 }
 
 // ChannelResolver resolves a Slack channel id to its workspace id. Tests
@@ -69,8 +82,31 @@ func (r *RegistryResolver) WorkspaceSchema(ws types.WorkspaceID) *configmodel.Fi
 // NewUseCase builds a triage UseCase around an executor. registry is used
 // by the HTTP interaction handler to map a Slack interaction back to its
 // workspace before the submission is processed.
-func NewUseCase(executor *PlanExecutor, registry ChannelResolver) *UseCase {
-	return &UseCase{executor: executor, registry: registry}
+func NewUseCase(executor *PlanExecutor, registry ChannelResolver, opts ...UseCaseOption) *UseCase {
+	u := &UseCase{executor: executor, registry: registry}
+	for _, opt := range opts {
+		opt(u)
+	}
+	return u
+}
+
+// dispatchRun schedules the planner loop for ticket in the background. When
+// the triage pool is saturated the run is not queued; instead the failure
+// notice with a retry button is posted to the thread, so the reporter can
+// restart triage once load has dropped.
+func (u *UseCase) dispatchRun(ctx context.Context, wsID types.WorkspaceID, ticket *model.Ticket) {
+	id := ticket.ID
+	run := func(ctx context.Context) error {
+		return u.executor.run(ctx, wsID, id)
+	}
+
+	if u.pool == nil {
+		async.Dispatch(ctx, run)
+		return
+	}
+	if err := u.pool.Submit(ctx, run); err != nil {
+		u.executor.reportFailure(ctx, ticket, err)
+	}
 }
 
 // ticketRef forwards to the executor's TicketRef builder so review-flow
@@ -122,18 +158,15 @@ func (u *UseCase) invalidateForm(ctx context.Context, ticket *model.Ticket, chan
 
 // OnTicketCreated is Entry-1: invoked by SlackUseCase.HandleNewMessage right
 // after a ticket has been created. It schedules the planner loop in the
-// background and returns immediately so the original Slack handler stays
-// fast. The repeated ticket.Triaged check inside Run guarantees that
-// duplicate dispatches (e.g. event re-deliveries) do not re-run triage.
+// background (on the triage pool when configured) and returns immediately
+// so the original Slack handler stays fast. The repeated ticket.Triaged
+// check inside Run guarantees that duplicate dispatches (e.g. event
+// re-deliveries) do not re-run triage.
 func (u *UseCase) OnTicketCreated(ctx context.Context, ticket *model.Ticket) {
 	if ticket == nil {
 		return
 	}
-	wsID := ticket.WorkspaceID
-	id := ticket.ID
-	async.Dispatch(ctx, func(ctx context.Context) error {
-		return u.executor.run(ctx, wsID, id)
-	})
+	u.dispatchRun(ctx, ticket.WorkspaceID, ticket)
 }
 
 // HandleSubmit is Entry-2: invoked by the HTTP interactions handler when
@@ -201,10 +234,7 @@ func (u *UseCase) HandleSubmit(ctx context.Context, sub Submission) error {
 		logger.Warn("failed to update ask message", slog.String("error", err.Error()))
 	}
 
-	tkID := sub.TicketID
-	async.Dispatch(ctx, func(ctx context.Context) error {
-		return u.executor.run(ctx, wsID, tkID)
-	})
+	u.dispatchRun(ctx, wsID, ticket)
 	return nil
 }
 
@@ -333,10 +363,7 @@ func (u *UseCase) HandleRetry(ctx context.Context, ticketID types.TicketID, chan
 		logger.Warn("failed to update retry message", slog.String("error", err.Error()))
 	}
 
-	tkID := ticketID
-	async.Dispatch(ctx, func(ctx context.Context) error {
-		return u.executor.run(ctx, wsID, tkID)
-	})
+	u.dispatchRun(ctx, wsID, ticket)
 	return nil
 }
 
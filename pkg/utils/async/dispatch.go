@@ -18,18 +18,7 @@ func Dispatch(ctx context.Context, handler func(ctx context.Context) error) {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		defer func() {
-			if r := recover(); r != nil {
-				stack := debug.Stack()
-				errutil.Handle(newCtx, goerr.New("panic in async handler",
-					goerr.V("recover", r),
-					goerr.V("stack", string(stack))))
-			}
-		}()
-
-		if err := handler(newCtx); err != nil {
-			errutil.Handle(newCtx, err)
-		}
+		run(newCtx, handler)
 	}()
 }
 
@@ -37,6 +26,24 @@ func Wait() {
 	wg.Wait()
 }
 
+// run invokes handler, turning both a returned error and a panic into an
+// errutil.Handle call. It is shared by Dispatch and Pool workers so the two
+// paths report failures identically.
+func run(ctx context.Context, handler func(ctx context.Context) error) {
+	defer func() {
+		if r := recover(); r != nil {
+			stack := debug.Stack()
+			errutil.Handle(ctx, goerr.New("panic in async handler",
+				goerr.V("recover", r),
+				goerr.V("stack", string(stack))))
+		}
+	}()
+
+	if err := handler(ctx); err != nil {
+		errutil.Handle(ctx, err)
+	}
+}
+
 func newBackgroundContext(ctx context.Context) context.Context {
 	newCtx := context.Background()
 	newCtx = logging.With(newCtx, logging.From(ctx))
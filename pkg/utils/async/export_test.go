@@ -0,0 +1,6 @@
+package async
+
+var (
+	ErrQueueFullForTest  = errQueueFull
+	ErrPoolClosedForTest = errPoolClosed
+)
@@ -0,0 +1,97 @@
+package async
+
+import (
+	"context"
+	"sync"
+
+	"github.com/m-mizutani/goerr/v2"
+)
+
+// errQueueFull is returned by Pool.Submit when every worker is busy and the
+// queue has no free slot. Callers at the HTTP edge translate it into a 503
+// so the sender retries later instead of the process spawning unbounded
+// goroutines.
+var errQueueFull = goerr.New("async pool queue is full")
+
+// errPoolClosed is returned by Pool.Submit after Close has been called.
+var errPoolClosed = goerr.New("async pool is closed")
+
+// Pool is a bounded alternative to Dispatch: a fixed number of workers drain
+// a fixed-size queue. Submit never blocks — when the queue is full the
+// handler is rejected with errQueueFull.
+//
+// Handlers accepted by the pool are tracked by the same wait group as
+// Dispatch, so Wait() also covers work queued here.
+type Pool struct {
+	queue chan func()
+
+	mu     sync.RWMutex
+	closed bool
+	done   sync.WaitGroup
+}
+
+// NewPool starts concurrency workers reading from a queue of queueSize
+// pending handlers. Non-positive values are clamped to 1 and 0 respectively;
+// a zero queue means a handler is only accepted when a worker is idle.
+func NewPool(concurrency, queueSize int) *Pool {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	if queueSize < 0 {
+		queueSize = 0
+	}
+
+	p := &Pool{
+		queue: make(chan func(), queueSize),
+	}
+	p.done.Add(concurrency)
+	for range concurrency {
+		go func() {
+			defer p.done.Done()
+			for job := range p.queue {
+				job()
+			}
+		}()
+	}
+	return p
+}
+
+// Submit enqueues handler for execution on a worker. Like Dispatch, the
+// handler runs with a background context that inherits only the logger from
+// ctx, and errors or panics are reported through errutil.Handle.
+func (p *Pool) Submit(ctx context.Context, handler func(ctx context.Context) error) error {
+	newCtx := newBackgroundContext(ctx)
+	job := func() {
+		defer wg.Done()
+		run(newCtx, handler)
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.closed {
+		return errPoolClosed
+	}
+
+	wg.Add(1)
+	select {
+	case p.queue <- job:
+		return nil
+	default:
+		wg.Done()
+		return goerr.Wrap(errQueueFull, "failed to submit handler",
+			goerr.V("queue_size", cap(p.queue)))
+	}
+}
+
+// Close stops accepting new handlers and blocks until every queued handler
+// has finished. It is safe to call more than once.
+func (p *Pool) Close() {
+	p.mu.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.queue)
+	}
+	p.mu.Unlock()
+
+	p.done.Wait()
+}
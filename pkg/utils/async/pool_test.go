@@ -0,0 +1,73 @@
+package async_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+	"github.com/m-mizutani/shepherd/pkg/utils/async"
+)
+
+func TestPool_RunsSubmittedHandlers(t *testing.T) {
+	pool := async.NewPool(2, 8)
+
+	var counter atomic.Int32
+	for range 5 {
+		gt.NoError(t, pool.Submit(context.Background(), func(ctx context.Context) error {
+			counter.Add(1)
+			return nil
+		}))
+	}
+	pool.Close()
+
+	gt.N(t, int(counter.Load())).Equal(5)
+}
+
+func TestPool_QueueFull(t *testing.T) {
+	pool := async.NewPool(1, 1)
+	defer pool.Close()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	// Occupies the only worker until released.
+	gt.NoError(t, pool.Submit(context.Background(), func(ctx context.Context) error {
+		close(started)
+		<-release
+		return nil
+	}))
+	<-started
+
+	// Fills the single queue slot.
+	gt.NoError(t, pool.Submit(context.Background(), func(ctx context.Context) error { return nil }))
+
+	err := pool.Submit(context.Background(), func(ctx context.Context) error { return nil })
+	gt.Error(t, err)
+	gt.True(t, errors.Is(err, async.ErrQueueFullForTest))
+
+	close(release)
+}
+
+func TestPool_PanicRecovered(t *testing.T) {
+	pool := async.NewPool(1, 2)
+
+	var completed atomic.Int32
+	gt.NoError(t, pool.Submit(context.Background(), func(ctx context.Context) error { panic("boom") }))
+	gt.NoError(t, pool.Submit(context.Background(), func(ctx context.Context) error {
+		completed.Add(1)
+		return nil
+	}))
+	pool.Close()
+
+	// The worker must survive the panic and keep draining the queue.
+	gt.N(t, int(completed.Load())).Equal(1)
+}
+
+func TestPool_SubmitAfterClose(t *testing.T) {
+	pool := async.NewPool(1, 1)
+	pool.Close()
+
+	err := pool.Submit(context.Background(), func(ctx context.Context) error { return nil })
+	gt.True(t, errors.Is(err, async.ErrPoolClosedForTest))
+}
@@ -0,0 +1,6 @@
+package errutil
+
+var (
+	SentryTagValueForTest = sentryTagValue
+	SentryTagsForTest     = sentryTags
+)
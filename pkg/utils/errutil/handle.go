@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"net/http"
 	"os"
+	"reflect"
 
 	"github.com/getsentry/sentry-go"
 	"github.com/m-mizutani/goerr/v2"
@@ -36,6 +37,7 @@ func Handle(ctx context.Context, err error) {
 		if len(values) > 0 {
 			scope.SetContext("goerr_values", values)
 		}
+		scope.SetTags(sentryTags(values))
 	})
 	evID := hub.CaptureException(err)
 	logAttrs = append(logAttrs, slog.Any("sentry.id", evID))
@@ -43,6 +45,79 @@ func Handle(ctx context.Context, err error) {
 	logger.Error("Error: "+err.Error(), logAttrs...)
 }
 
+// sentryTagKeys lists the goerr keys promoted to Sentry tags. Only
+// identifiers and small enums are included: tags are indexed, so free-form
+// values (raw LLM output, search queries, tool input) must stay in the
+// goerr_values context, and keys such as "level" or "url" would overwrite
+// Sentry's built-in tags. Names come from keys.go so the allowlist and the
+// keys callers set cannot drift apart.
+var sentryTagKeys = keyNames(
+	WorkspaceIDKey.Name(),
+	TicketIDKey.Name(),
+	ChannelIDKey.Name(),
+	ThreadTSKey.Name(),
+	MessageTSKey.Name(),
+	UserIDKey.Name(),
+	PromptIDKey.Name(),
+	FieldIDKey.Name(),
+	StatusIDKey.Name(),
+	SourceIDKey.Name(),
+	ProviderIDKey.Name(),
+	SessionIDKey.Name(),
+	TraceIDKey.Name(),
+	ProviderKey.Name(),
+	KindKey.Name(),
+)
+
+func keyNames(names ...string) map[string]struct{} {
+	set := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		set[name] = struct{}{}
+	}
+	return set
+}
+
+// sentryTags picks the allowlisted goerr values that convert to a tag.
+func sentryTags(values map[string]any) map[string]string {
+	tags := map[string]string{}
+	for k, v := range values {
+		if _, ok := sentryTagKeys[k]; !ok {
+			continue
+		}
+		if tag, ok := sentryTagValue(v); ok {
+			tags[k] = tag
+		}
+	}
+	return tags
+}
+
+// sentryMaxTagValueLen is Sentry's upper bound on a tag value; longer values
+// (stack traces, raw LLM output) stay in the goerr_values context only.
+const sentryMaxTagValueLen = 200
+
+// sentryTagValue converts a goerr value into a Sentry tag when it is a
+// scalar (including named types such as types.TicketID) short enough to be
+// indexed.
+func sentryTagValue(v any) (string, bool) {
+	if v == nil {
+		return "", false
+	}
+	switch reflect.ValueOf(v).Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+	default:
+		return "", false
+	}
+
+	s := fmt.Sprint(v)
+	if s == "" || len(s) > sentryMaxTagValueLen {
+		return "", false
+	}
+	return s, true
+}
+
 func HandleHTTP(ctx context.Context, w http.ResponseWriter, err error, statusCode int) {
 	if err == nil {
 		return
@@ -0,0 +1,62 @@
+package errutil_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+	"github.com/m-mizutani/shepherd/pkg/utils/errutil"
+)
+
+type namedID string
+
+func TestSentryTagValue(t *testing.T) {
+	cases := []struct {
+		name   string
+		input  any
+		want   string
+		wantOK bool
+	}{
+		{name: "string", input: "ws-1", want: "ws-1", wantOK: true},
+		{name: "named string", input: namedID("tk-1"), want: "tk-1", wantOK: true},
+		{name: "int", input: 42, want: "42", wantOK: true},
+		{name: "bool", input: true, want: "true", wantOK: true},
+		{name: "float", input: 1.5, want: "1.5", wantOK: true},
+		{name: "max length", input: strings.Repeat("x", 200), want: strings.Repeat("x", 200), wantOK: true},
+		{name: "too long", input: strings.Repeat("x", 201)},
+		{name: "empty string", input: ""},
+		{name: "nil", input: nil},
+		{name: "slice", input: []string{"a"}},
+		{name: "map", input: map[string]string{"a": "b"}},
+		{name: "struct", input: struct{ A string }{A: "a"}},
+		{name: "pointer", input: new(string)},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := errutil.SentryTagValueForTest(tc.input)
+			gt.Equal(t, ok, tc.wantOK)
+			gt.Equal(t, got, tc.want)
+		})
+	}
+}
+
+func TestSentryTags(t *testing.T) {
+	tags := errutil.SentryTagsForTest(map[string]any{
+		"ticket_id":    namedID("tk-1"),
+		"workspace_id": "ws-1",
+		"kind":         "slack",
+		"level":        "warning",
+		"url":          "https://example.com",
+		"raw":          "LLM output",
+		"query":        "user search text",
+		"channel_id":   []string{"not", "scalar"},
+		"http_status":  500,
+	})
+
+	gt.Equal(t, tags, map[string]string{
+		"ticket_id":    "tk-1",
+		"workspace_id": "ws-1",
+		"kind":         "slack",
+	})
+}
@@ -14,6 +14,13 @@ var (
 	UserIDKey      = goerr.NewTypedKey[string]("user_id")
 	RequestIDKey   = goerr.NewTypedKey[string]("request_id")
 	TokenIDKey     = goerr.NewTypedKey[string]("token_id")
+	PromptIDKey    = goerr.NewTypedKey[string]("prompt_id")
+	FieldIDKey     = goerr.NewTypedKey[string]("field_id")
+	StatusIDKey    = goerr.NewTypedKey[string]("status_id")
+	SourceIDKey    = goerr.NewTypedKey[string]("source_id")
+	ProviderIDKey  = goerr.NewTypedKey[string]("provider_id")
+	SessionIDKey   = goerr.NewTypedKey[string]("session_id")
+	TraceIDKey     = goerr.NewTypedKey[string]("trace_id")
 
 	// Field names
 	FieldKey        = goerr.NewTypedKey[string]("field")
@@ -25,6 +32,8 @@ var (
 	OperationKey  = goerr.NewTypedKey[string]("operation")
 	RepositoryKey = goerr.NewTypedKey[string]("repository")
 	CollectionKey = goerr.NewTypedKey[string]("collection")
+	ProviderKey   = goerr.NewTypedKey[string]("provider")
+	KindKey       = goerr.NewTypedKey[string]("kind")
 	DurationKey   = goerr.NewTypedKey[time.Duration]("duration")
 
 	// HTTP
@@ -33,6 +42,7 @@ var (
 
 	// Slack
 	ChannelIDKey = goerr.NewTypedKey[string]("channel_id")
+	ThreadTSKey  = goerr.NewTypedKey[string]("thread_ts")
 	MessageTSKey = goerr.NewTypedKey[string]("message_ts")
 	SlackUserKey = goerr.NewTypedKey[string]("slack_user")
 